@@ -36,6 +36,31 @@ type AddressPoolStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (a *AddressPool) GetInsync() bool {
+	return a.Status.InSync
+}
+
+func (a *AddressPool) GetReconciled() bool {
+	return a.Status.Reconciled
+}
+
+func (a *AddressPool) GetConditions() []metav1.Condition {
+	return a.Status.Conditions
+}
+
+func (a *AddressPool) SetConditions(conditions []metav1.Condition) {
+	a.Status.Conditions = conditions
 }
 
 // AllocationRange defines the start and end address for an allocation range
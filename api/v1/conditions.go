@@ -0,0 +1,73 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+// Defines the standard condition types reported in the status of every
+// resource.  Not every resource reports every condition; for example, the
+// InSync condition is only reported by resources that track whether their
+// configuration matches the system.
+const (
+	// ConditionReady is True when the resource has been reconciled, its
+	// configuration matches the system and no error is outstanding.
+	ConditionReady = "Ready"
+
+	// ConditionInSync is True when the desired configuration matches the
+	// configuration of the system.
+	ConditionInSync = "InSync"
+
+	// ConditionReconciling is True while the controller is still working
+	// towards the desired configuration; i.e., it will retry on its own.
+	ConditionReconciling = "Reconciling"
+
+	// ConditionWaitingForDependency is True when the reconciliation is blocked
+	// waiting for another resource or for a state change on the system.
+	ConditionWaitingForDependency = "WaitingForDependency"
+
+	// ConditionStrategyRequired is True when applying the configuration
+	// requires an update strategy; i.e., a host lock and unlock.
+	ConditionStrategyRequired = "StrategyRequired"
+
+	// ConditionDegraded is True when the last reconciliation failed with an
+	// error other than waiting for a dependency.
+	ConditionDegraded = "Degraded"
+
+	// ConditionPaused is True when the deployment manager has been asked to
+	// stop acting on the resource, either directly or through the System
+	// resource of its namespace.
+	ConditionPaused = "Paused"
+
+	// ConditionDrifted is True when the configuration of the system no longer
+	// matches the desired configuration after the resource was reconciled.
+	// It is only reported by reconcilers in the drift detection mode.
+	ConditionDrifted = "Drifted"
+)
+
+// Defines the condition reasons which do not originate from a reconciler
+// error.  Reasons for errors are derived from the error class.
+const (
+	ReasonReconciled          = "Reconciled"
+	ReasonNotReconciled       = "NotReconciled"
+	ReasonInSync              = "InSync"
+	ReasonOutOfSync           = "OutOfSync"
+	ReasonReconcileComplete   = "ReconcileComplete"
+	ReasonDependenciesReady   = "DependenciesReady"
+	ReasonStrategyNotRequired = "StrategyNotRequired"
+	ReasonNoErrors            = "NoErrors"
+
+	// ReasonPauseRequested and ReasonSystemPaused are reported by the Paused
+	// condition when the resource itself or the System resource of its
+	// namespace is paused.
+	ReasonPauseRequested = "PauseRequested"
+	ReasonSystemPaused   = "SystemPaused"
+	ReasonNotPaused      = "NotPaused"
+
+	// ReasonDriftDetected and ReasonNoDrift are reported by the Drifted
+	// condition.
+	ReasonDriftDetected = "DriftDetected"
+	ReasonNoDrift       = "NoDrift"
+
+	// ReasonMonitorDeadlineExceeded is reported by the Degraded condition
+	// when a monitor is still waiting after its deadline has passed.
+	ReasonMonitorDeadlineExceeded = "MonitorDeadlineExceeded"
+)
@@ -102,6 +102,31 @@ type DataNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (d *DataNetwork) GetInsync() bool {
+	return d.Status.InSync
+}
+
+func (d *DataNetwork) GetReconciled() bool {
+	return d.Status.Reconciled
+}
+
+func (d *DataNetwork) GetConditions() []metav1.Condition {
+	return d.Status.Conditions
+}
+
+func (d *DataNetwork) SetConditions(conditions []metav1.Condition) {
+	d.Status.Conditions = conditions
 }
 
 func (d *DataNetwork) GetStrategyRequired() string {
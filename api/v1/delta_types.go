@@ -0,0 +1,35 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+// Defines the operations reported by a delta entry.
+const (
+	DeltaOpAdd     = "add"
+	DeltaOpRemove  = "remove"
+	DeltaOpReplace = "replace"
+)
+
+// DeltaEntry defines a single difference between the desired configuration
+// and the configuration observed on the system.
+type DeltaEntry struct {
+	// Path identifies the attribute which differs using a dotted notation.
+	// List elements are identified by their name, or path, when available
+	// and otherwise by their index; e.g., interfaces.ethernet[name=oam0].mtu.
+	Path string `json:"path"`
+
+	// Op defines whether the attribute must be added, removed or replaced to
+	// reach the desired configuration.
+	// +kubebuilder:validation:Enum=add;remove;replace
+	Op string `json:"op"`
+
+	// Desired is the JSON encoded desired value of the attribute.  Sensitive
+	// values are redacted and long values are truncated.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Observed is the JSON encoded value of the attribute observed on the
+	// system.  Sensitive values are redacted and long values are truncated.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+}
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -88,6 +88,12 @@ type HostSpec struct {
 	// "profile" attribute.
 	// +optional
 	Overrides *HostProfileSpec `json:"overrides,omitempty"`
+
+	// Maintenance defines the maintenance windows during which disruptive
+	// actions are allowed on the host.  When set these replace the windows
+	// defined on the System resource.
+	// +optional
+	Maintenance *MaintenanceInfo `json:"maintenance,omitempty"`
 }
 
 // HostStatus defines the observed state of Host
@@ -153,6 +159,61 @@ type HostStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// DeltaEntries lists the differences between the desired configuration
+	// and the configuration observed on the system.  The list is bounded in
+	// size and sensitive values are redacted.
+	// +optional
+	DeltaEntries []DeltaEntry `json:"deltaEntries,omitempty"`
+
+	// DeltaTruncated indicates that more differences were found than are
+	// listed in DeltaEntries.
+	// +optional
+	DeltaTruncated bool `json:"deltaTruncated,omitempty"`
+
+	// Maintenance defines the disruptive action waiting for the next
+	// maintenance window, if any.
+	// +optional
+	Maintenance *MaintenanceWindowStatus `json:"maintenance,omitempty"`
+
+	// Monitor defines the background monitor which the reconciler is waiting
+	// on, if any.
+	// +optional
+	Monitor *MonitorStatus `json:"monitor,omitempty"`
+
+	// Plan defines the API requests which the reconciler would have sent to
+	// the system while the resource is in dry-run mode.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (h *Host) GetReconciled() bool {
+	return h.Status.Reconciled
+}
+
+func (h *Host) GetConditions() []metav1.Condition {
+	return h.Status.Conditions
+}
+
+func (h *Host) SetConditions(conditions []metav1.Condition) {
+	h.Status.Conditions = conditions
+}
+
+func (h *Host) SetStatusPlan(plan *PlanStatus) {
+	h.Status.Plan = plan
+}
+
+func (h *Host) GetStatusPlan() *PlanStatus {
+	return h.Status.Plan
 }
 
 func (h *Host) SetStatusDelta(delta string) {
@@ -163,6 +224,23 @@ func (h *Host) GetStatusDelta() string {
 	return h.Status.Delta
 }
 
+func (h *Host) SetStatusDeltaEntries(entries []DeltaEntry, truncated bool) {
+	h.Status.DeltaEntries = entries
+	h.Status.DeltaTruncated = truncated
+}
+
+func (h *Host) GetStatusDeltaEntries() []DeltaEntry {
+	return h.Status.DeltaEntries
+}
+
+func (h *Host) SetStatusMonitor(monitor *MonitorStatus) {
+	h.Status.Monitor = monitor
+}
+
+func (h *Host) GetStatusMonitor() *MonitorStatus {
+	return h.Status.Monitor
+}
+
 func (h *Host) GetInsync() bool {
 	return h.Status.InSync
 }
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -936,6 +936,26 @@ func (in *HostProfileSpec) HasWorkerSubFunction() bool {
 	return false
 }
 
+// HostProfileStatus defines the observed state of HostProfile
+type HostProfileStatus struct {
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (h *HostProfile) GetConditions() []metav1.Condition {
+	return h.Status.Conditions
+}
+
+func (h *HostProfile) SetConditions(conditions []metav1.Condition) {
+	h.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 // HostProfile defines the attributes that represent the host level
 // attributes of a StarlingX system.  This is represents the bulk of the
@@ -945,12 +965,14 @@ func (in *HostProfileSpec) HasWorkerSubFunction() bool {
 //	https://docs.starlingx.io/api-ref/stx-config/index.html
 //
 // +deepequal-gen=false
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="base",type="string",JSONPath=".spec.base",description="The parent host profile."
 type HostProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec HostProfileSpec `json:"spec,omitempty"`
+	Spec   HostProfileSpec   `json:"spec,omitempty"`
+	Status HostProfileStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
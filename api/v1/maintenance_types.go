@@ -0,0 +1,195 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// The time zone database is embedded since the deployment manager image
+	// does not necessarily include one.
+	_ "time/tzdata"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindowInfo defines a recurring period of time during which
+// disruptive actions are allowed.
+type MaintenanceWindowInfo struct {
+	// Days defines the days of the week on which the window opens.  The
+	// window opens every day if no days are listed.
+	// +kubebuilder:validation:items:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// Start defines the time of day at which the window opens in the 24 hour
+	// HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// Duration defines how long the window stays open (e.g., 4h, 90m, or
+	// 1h30m).  A window may extend past midnight but must not be longer than
+	// a week.
+	// +kubebuilder:validation:Pattern=`^([0-9]+h)?([0-9]+m)?$`
+	Duration string `json:"duration"`
+}
+
+// MaintenanceInfo defines the maintenance windows during which disruptive
+// actions, such as locking or reinstalling hosts and applying configuration
+// update strategies, are allowed.  Non-disruptive changes are reconciled at
+// any time.
+type MaintenanceInfo struct {
+	// Timezone defines the IANA time zone name in which the windows are
+	// expressed (e.g., America/Toronto).  Defaults to UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Windows defines the list of recurring maintenance windows.
+	// +kubebuilder:validation:MinItems=1
+	Windows []MaintenanceWindowInfo `json:"windows"`
+}
+
+// MaintenanceWindowStatus defines the disruptive action which is waiting for
+// the next maintenance window.
+type MaintenanceWindowStatus struct {
+	// Pending describes the disruptive action waiting for a maintenance
+	// window.
+	Pending string `json:"pending"`
+
+	// NextWindow defines when the next maintenance window opens.
+	// +optional
+	NextWindow *metav1.Time `json:"nextWindow,omitempty"`
+}
+
+// maxMaintenanceWindowDuration defines the longest supported window.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// parse returns the time of day, in minutes, at which the window opens along
+// with its duration.
+func (in *MaintenanceWindowInfo) parse() (int, time.Duration, error) {
+	start, err := time.Parse("15:04", in.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maintenance window start time: %q", in.Start)
+	}
+
+	duration, err := time.ParseDuration(in.Duration)
+	if err != nil || duration <= 0 || duration > maxMaintenanceWindowDuration {
+		return 0, 0, fmt.Errorf("invalid maintenance window duration: %q", in.Duration)
+	}
+
+	for _, day := range in.Days {
+		if _, ok := weekdays[strings.ToLower(day)]; !ok {
+			return 0, 0, fmt.Errorf("invalid maintenance window day: %q", day)
+		}
+	}
+
+	return start.Hour()*60 + start.Minute(), duration, nil
+}
+
+// weekdays maps the day names accepted in a window to their weekday.
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// opensOn determines whether the window opens on a given day of the week.
+func (in *MaintenanceWindowInfo) opensOn(day time.Weekday) bool {
+	if len(in.Days) == 0 {
+		return true
+	}
+
+	for _, d := range in.Days {
+		if weekdays[strings.ToLower(d)] == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// location returns the time zone in which the windows are expressed.
+func (in *MaintenanceInfo) location() (*time.Location, error) {
+	if in.Timezone == nil || *in.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(*in.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance timezone: %q", *in.Timezone)
+	}
+
+	return loc, nil
+}
+
+// Validate determines whether the maintenance windows are well formed.
+func (in *MaintenanceInfo) Validate() error {
+	if _, err := in.location(); err != nil {
+		return err
+	}
+
+	if len(in.Windows) == 0 {
+		return fmt.Errorf("at least one maintenance window must be specified")
+	}
+
+	for i := range in.Windows {
+		if _, _, err := in.Windows[i].parse(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsOpen determines whether a maintenance window is open at the given time.
+// If no window is open then the time at which the next window opens is
+// returned.  Disruptive actions are always allowed if no maintenance windows
+// are defined.
+func (in *MaintenanceInfo) IsOpen(now time.Time) (open bool, next time.Time, err error) {
+	if in == nil || len(in.Windows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	loc, err := in.location()
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	local := now.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	for i := range in.Windows {
+		window := &in.Windows[i]
+
+		start, duration, err := window.parse()
+		if err != nil {
+			return false, time.Time{}, err
+		}
+
+		// Windows which opened up to a week ago may still be open, and the
+		// next window opens within a week.
+		for d := -7; d <= 7; d++ {
+			day := midnight.AddDate(0, 0, d)
+			if !window.opensOn(day.Weekday()) {
+				continue
+			}
+
+			opens := time.Date(day.Year(), day.Month(), day.Day(), start/60, start%60, 0, 0, loc)
+			if !now.Before(opens) && now.Before(opens.Add(duration)) {
+				return true, time.Time{}, nil
+			}
+
+			if opens.After(now) && (next.IsZero() || opens.Before(next)) {
+				next = opens
+			}
+		}
+	}
+
+	return false, next, nil
+}
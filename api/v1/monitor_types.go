@@ -0,0 +1,43 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MonitorStatus defines the state of the background monitor which the
+// reconciler is waiting on before it can continue to process a resource.
+type MonitorStatus struct {
+	// Type defines the type of monitor (e.g., stateMonitor).
+	Type string `json:"type"`
+
+	// Message defines the last state reported by the monitor (e.g., waiting
+	// for host to become unlocked/enabled).
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Interval defines the time between each polling attempt (e.g., 30s).
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// StartTime defines when the monitor was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Deadline defines how long the monitor may wait before the resource is
+	// reported as degraded (e.g., 30m).
+	// +optional
+	Deadline string `json:"deadline,omitempty"`
+
+	// DeadlineExceeded indicates that the monitor is still waiting after its
+	// deadline has passed.
+	// +optional
+	DeadlineExceeded bool `json:"deadlineExceeded,omitempty"`
+
+	// LastError defines the last error encountered by the monitor while
+	// querying the system.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
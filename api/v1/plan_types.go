@@ -0,0 +1,55 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlannedRequest defines a single API request which the reconciler would have
+// sent to the system if the resource was not in dry-run mode.
+type PlannedRequest struct {
+	// Method defines the HTTP method of the request (e.g., POST, PATCH).
+	Method string `json:"method"`
+
+	// Path defines the URL path and query of the request (e.g.,
+	// /v1/ihosts/<uuid>).
+	Path string `json:"path"`
+
+	// Body is the JSON encoded body of the request.  Sensitive values are
+	// redacted and long bodies are truncated.
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
+// PlanStatus defines the list of API requests which the reconciler would have
+// sent to the system in order to reach the desired configuration.  It is
+// only reported while the resource is in dry-run mode.
+type PlanStatus struct {
+	// ObservedGeneration defines the generation of the resource for which the
+	// plan was computed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// GeneratedAt defines when the content of the plan last changed.
+	// +optional
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty"`
+
+	// Requests defines the list of requests in the order that they would
+	// have been sent.
+	// +optional
+	Requests []PlannedRequest `json:"requests,omitempty"`
+
+	// Truncated indicates whether some requests were omitted because of the
+	// size limit.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Error defines the error which stopped the reconciler before it could
+	// complete the plan, if any.  The reconciler may be unable to plan the
+	// requests which depend on a state change of the system (e.g., after a
+	// host is locked) therefore the plan may be incomplete.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
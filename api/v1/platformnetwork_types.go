@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -58,6 +58,44 @@ type PlatformNetworkStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the API requests which the reconciler would have sent to
+	// the system while the resource is in dry-run mode.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (p *PlatformNetwork) GetInsync() bool {
+	return p.Status.InSync
+}
+
+func (p *PlatformNetwork) GetReconciled() bool {
+	return p.Status.Reconciled
+}
+
+func (p *PlatformNetwork) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *PlatformNetwork) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func (p *PlatformNetwork) SetStatusPlan(plan *PlanStatus) {
+	p.Status.Plan = plan
+}
+
+func (p *PlatformNetwork) GetStatusPlan() *PlanStatus {
+	return p.Status.Plan
 }
 
 func (p *PlatformNetwork) GetStrategyRequired() string {
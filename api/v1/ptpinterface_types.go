@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022 Wind River Systems, Inc. */
+/* Copyright(c) 2022, 2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -61,6 +61,44 @@ type PtpInterfaceStatus struct {
 	// Delta between final profile vs current configuration
 	// +optional
 	Delta string `json:"delta"`
+
+	// Plan defines the API requests which the reconciler would have sent to
+	// the system while the resource is in dry-run mode.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (p *PtpInterface) GetInsync() bool {
+	return p.Status.InSync
+}
+
+func (p *PtpInterface) GetReconciled() bool {
+	return p.Status.Reconciled
+}
+
+func (p *PtpInterface) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *PtpInterface) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+func (p *PtpInterface) SetStatusPlan(plan *PlanStatus) {
+	p.Status.Plan = plan
+}
+
+func (p *PtpInterface) GetStatusPlan() *PlanStatus {
+	return p.Status.Plan
 }
 
 func (p *PtpInterface) GetStrategyRequired() string {
@@ -0,0 +1,107 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Defines the supported deployment scopes.
+const (
+	// ScopeBootstrap defines the scope of the initial installation (Day-1).
+	// Changes are no longer applied once the resource has been reconciled.
+	ScopeBootstrap = "bootstrap"
+
+	// ScopePrincipal defines the scope of configuration updates applied to a
+	// running system (Day-2).
+	ScopePrincipal = "principal"
+)
+
+// DeploymentScopeKey is the annotation which defines the deployment scope of
+// a resource.  It takes precedence over the deprecated deploymentScope status
+// attribute of the last applied configuration.
+const DeploymentScopeKey = "deployment-manager/deployment-scope"
+
+// LastAppliedConfigKey is the annotation maintained by "kubectl apply" which
+// holds the last applied configuration of a resource.
+const LastAppliedConfigKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Defines where the deployment scope of a resource was found.
+const (
+	ScopeSourceAnnotation  = "annotation"
+	ScopeSourceLastApplied = "last-applied-configuration"
+	ScopeSourceDefault     = "default"
+)
+
+// IsValidDeploymentScope determines whether a value is one of the supported
+// deployment scopes.
+func IsValidDeploymentScope(scope string) bool {
+	return scope == ScopeBootstrap || scope == ScopePrincipal
+}
+
+// lastAppliedDeploymentScope returns the deploymentScope status attribute
+// of a last applied configuration, if any.
+func lastAppliedDeploymentScope(config string) (string, error) {
+	var data map[string]interface{}
+	err := json.Unmarshal([]byte(config), &data)
+	if err != nil {
+		return "", err
+	}
+
+	status, ok := data["status"].(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	scope, ok := status["deploymentScope"].(string)
+	if !ok {
+		return "", nil
+	}
+
+	return scope, nil
+}
+
+// GetDeclaredDeploymentScope returns the deployment scope requested for a
+// resource along with where it was found.  The DeploymentScopeKey annotation
+// is used if present.  Otherwise, for backward compatibility with
+// configurations applied with "kubectl apply", the deploymentScope status
+// attribute of the last applied configuration is used.  The bootstrap scope
+// is returned if neither is set, or along with an error if the value is
+// invalid.
+func GetDeclaredDeploymentScope(object metav1.Object) (scope string, source string, err error) {
+	annotations := object.GetAnnotations()
+
+	if value, ok := annotations[DeploymentScopeKey]; ok {
+		if !IsValidDeploymentScope(value) {
+			return ScopeBootstrap, ScopeSourceAnnotation,
+				fmt.Errorf("unsupported %s annotation value: %q", DeploymentScopeKey, value)
+		}
+
+		return value, ScopeSourceAnnotation, nil
+	}
+
+	config, ok := annotations[LastAppliedConfigKey]
+	if !ok {
+		return ScopeBootstrap, ScopeSourceDefault, nil
+	}
+
+	value, err := lastAppliedDeploymentScope(config)
+	if err != nil {
+		return ScopeBootstrap, ScopeSourceLastApplied, err
+	} else if value == "" {
+		return ScopeBootstrap, ScopeSourceDefault, nil
+	}
+
+	// The status attribute was never validated so accept any letter case.
+	scope = strings.ToLower(value)
+	if !IsValidDeploymentScope(scope) {
+		return ScopeBootstrap, ScopeSourceLastApplied, fmt.Errorf("unsupported DeploymentScope: %s", value)
+	}
+
+	return scope, ScopeSourceLastApplied, nil
+}
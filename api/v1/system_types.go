@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -263,6 +263,98 @@ type PTPInfo struct {
 	Mechanism *string `json:"mechanism,omitempty"`
 }
 
+const (
+	// List of valid strategy alarm restriction values.
+	StrategyAlarmRestrictionsStrict  = "strict"
+	StrategyAlarmRestrictionsRelaxed = "relaxed"
+
+	// List of valid strategy instance action values.
+	StrategyInstanceActionStopStart = "stop-start"
+	StrategyInstanceActionMigrate   = "migrate"
+
+	// List of valid strategy apply types.
+	StrategyApplyTypeSerial   = "serial"
+	StrategyApplyTypeParallel = "parallel"
+
+	// Range of valid strategy parallel worker values.
+	StrategyMinParallelWorkers = 2
+	StrategyMaxParallelWorkers = 100
+
+	// Range of valid strategy retry values.
+	StrategyMinRetries       = 1
+	StrategyMaxRetries       = 1000
+	StrategyMinRetryInterval = 15
+	StrategyMaxRetryInterval = 86400
+
+	// List of valid strategy approval modes.
+	StrategyApprovalAuto   = "auto"
+	StrategyApprovalManual = "manual"
+	StrategyApprovalStage  = "stage"
+)
+
+// StrategyInfo defines the options used when the deployment manager creates
+// a system configuration update strategy to apply changes which require hosts
+// to be locked and unlocked.  Any option which is not specified keeps its
+// default value.
+// +deepequal-gen:ignore-nil-fields=true
+type StrategyInfo struct {
+	// AlarmRestrictions defines whether the strategy should be blocked by
+	// management affecting alarms only (relaxed) or by any alarm (strict).
+	// Defaults to strict.
+	// +kubebuilder:validation:Enum=strict;relaxed
+	// +optional
+	AlarmRestrictions *string `json:"alarmRestrictions,omitempty"`
+
+	// WorkerApplyType defines whether worker hosts are updated one at a time
+	// (serial) or in groups (parallel).  Defaults to parallel.
+	// +kubebuilder:validation:Enum=serial;parallel
+	// +optional
+	WorkerApplyType *string `json:"workerApplyType,omitempty"`
+
+	// StorageApplyType defines whether storage hosts are updated one at a
+	// time (serial) or in groups (parallel).  Defaults to serial.
+	// +kubebuilder:validation:Enum=serial;parallel
+	// +optional
+	StorageApplyType *string `json:"storageApplyType,omitempty"`
+
+	// MaxParallelWorkers defines the maximum number of worker hosts updated
+	// at the same time when the worker apply type is parallel.  Defaults to
+	// 10.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxParallelWorkers *int `json:"maxParallelWorkers,omitempty"`
+
+	// DefaultInstanceAction defines how instances running on worker hosts are
+	// handled while the hosts are updated.  Defaults to stop-start.
+	// +kubebuilder:validation:Enum=stop-start;migrate
+	// +optional
+	DefaultInstanceAction *string `json:"defaultInstanceAction,omitempty"`
+
+	// ApprovalMode defines whether a strategy is applied as soon as it is
+	// built (auto), only once it has been approved (manual), or one stage at
+	// a time as each stage is approved (stage).  Defaults to auto.
+	// +kubebuilder:validation:Enum=auto;manual;stage
+	// +optional
+	ApprovalMode *string `json:"approvalMode,omitempty"`
+
+	// MaxRetries defines how many consecutive times a failed request to
+	// create or apply a strategy is retried before the strategy is abandoned.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// MaxRetryInterval defines the longest delay, in seconds, between two
+	// retries.  The delay starts at 15 seconds and doubles after each failed
+	// request until it reaches this value.  Defaults to 600.
+	// +kubebuilder:validation:Minimum=15
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	MaxRetryInterval *int `json:"maxRetryInterval,omitempty"`
+}
+
 // DNSServerList defines a type to represent a slice of DNSServer objects.
 // +deepequal-gen:unordered-array=true
 type DNSServerList []string
@@ -349,6 +441,20 @@ type SystemSpec struct {
 	// vswitch implementation.
 	// +optional
 	VSwitchType *string `json:"vswitchType,omitempty"`
+
+	// Strategy defines the options of the strategies created by the deployment
+	// manager to apply changes that require hosts to be locked.  These are
+	// deployment manager settings rather than system attributes and are
+	// therefore not compared against the running configuration.
+	// +optional
+	Strategy *StrategyInfo `json:"strategy,omitempty"`
+
+	// Maintenance defines the maintenance windows during which disruptive
+	// actions are allowed on the system.  Hosts may override these windows.
+	// Like the strategy options these are deployment manager settings and
+	// are not compared against the running configuration.
+	// +optional
+	Maintenance *MaintenanceInfo `json:"maintenance,omitempty"`
 }
 
 // IsKeyEqual compares two controller file system array elements and determines
@@ -370,6 +476,115 @@ func (in ServiceParameterInfo) IsKeyEqual(x ServiceParameterInfo) bool {
 	return false
 }
 
+// SystemStrategyStatus defines the progress of the VIM strategy most recently
+// used to apply configuration changes to the system.  It is retained after
+// the strategy is deleted so that the outcome, and the reason for any
+// failure, remains visible.
+type SystemStrategyStatus struct {
+	// ID defines the unique identifier assigned to the strategy by the VIM.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// State defines the last observed state of the strategy.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Phase defines the strategy phase which is currently in progress or
+	// which was last run (i.e., build, apply, or abort).
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// CurrentStage defines the index of the current stage of the phase.
+	// +optional
+	CurrentStage int `json:"currentStage,omitempty"`
+
+	// TotalStages defines the total number of stages of the phase.
+	// +optional
+	TotalStages int `json:"totalStages,omitempty"`
+
+	// StageName defines the name of the current stage of the phase.
+	// +optional
+	StageName string `json:"stageName,omitempty"`
+
+	// CompletionPercentage defines the progress of the current phase.
+	// +optional
+	CompletionPercentage int `json:"completionPercentage,omitempty"`
+
+	// FailureReason defines the last failure reason reported by the VIM for
+	// the strategy.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// PendingApproval defines whether the strategy, or its next stage, is
+	// waiting to be approved before being applied.
+	// +optional
+	PendingApproval bool `json:"pendingApproval,omitempty"`
+
+	// StartTime defines when the strategy was first observed.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// FinishTime defines when the strategy was observed to have reached a
+	// final state.
+	// +optional
+	FinishTime *metav1.Time `json:"finishTime,omitempty"`
+
+	// Triggers defines the resources whose changes required the strategy.
+	// +optional
+	Triggers []StrategyTrigger `json:"triggers,omitempty"`
+}
+
+// StrategyTrigger defines a resource whose changes required a strategy.
+type StrategyTrigger struct {
+	// Kind defines the type of resource (e.g., system, host).
+	Kind string `json:"kind"`
+
+	// Name defines the name of the resource.
+	Name string `json:"name"`
+
+	// Personality defines the personality of the host, if applicable.
+	// +optional
+	Personality string `json:"personality,omitempty"`
+
+	// StrategyRequired defines the type of strategy required by the
+	// resource.
+	// +optional
+	StrategyRequired string `json:"strategyRequired,omitempty"`
+}
+
+// StrategyHistoryEntry defines the outcome of a past strategy.
+type StrategyHistoryEntry struct {
+	// ID defines the unique identifier assigned to the strategy by the VIM.
+	// It is empty if the strategy could not be created.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Outcome defines the final state of the strategy (e.g., applied,
+	// apply-failed, aborted, or retries-exhausted).
+	Outcome string `json:"outcome"`
+
+	// FailureReason defines why the strategy did not complete successfully.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Triggers defines the resources whose changes required the strategy.
+	// +optional
+	Triggers []StrategyTrigger `json:"triggers,omitempty"`
+
+	// StartTime defines when the strategy was first observed.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// FinishTime defines when the strategy was observed to have reached a
+	// final state.
+	// +optional
+	FinishTime *metav1.Time `json:"finishTime,omitempty"`
+
+	// Duration defines how long the strategy took to complete (e.g., 12m30s).
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
 // SystemStatus defines the observed state of System
 type SystemStatus struct {
 	// ID defines the unique identifier assigned by the system.
@@ -430,6 +645,17 @@ type SystemStatus struct {
 	// +optional
 	Delta string `json:"delta"`
 
+	// DeltaEntries lists the differences between the desired configuration
+	// and the configuration observed on the system.  The list is bounded in
+	// size and sensitive values are redacted.
+	// +optional
+	DeltaEntries []DeltaEntry `json:"deltaEntries,omitempty"`
+
+	// DeltaTruncated indicates that more differences were found than are
+	// listed in DeltaEntries.
+	// +optional
+	DeltaTruncated bool `json:"deltaTruncated,omitempty"`
+
 	// Strategy monitor status information for Day 2 operation
 	// +optional
 	// +kubebuilder:default:=false
@@ -438,6 +664,60 @@ type SystemStatus struct {
 	// Strategy monitor retry count for Day 2 operation
 	// +optional
 	StrategyRetryCount int `json:"strategyRetryCount"`
+
+	// Strategy defines the progress of the VIM strategy used for Day 2
+	// operations.
+	// +optional
+	Strategy *SystemStrategyStatus `json:"strategy,omitempty"`
+
+	// StrategyHistory defines the outcome of the most recent strategies,
+	// oldest first.  The number of entries is bounded.
+	// +optional
+	StrategyHistory []StrategyHistoryEntry `json:"strategyHistory,omitempty"`
+
+	// Maintenance defines the disruptive action waiting for the next
+	// maintenance window, if any.
+	// +optional
+	Maintenance *MaintenanceWindowStatus `json:"maintenance,omitempty"`
+
+	// Monitor defines the background monitor which the reconciler is waiting
+	// on, if any.
+	// +optional
+	Monitor *MonitorStatus `json:"monitor,omitempty"`
+
+	// Plan defines the API requests which the reconciler would have sent to
+	// the system while the resource is in dry-run mode.
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// Conditions describe the current state of the resource using the
+	// standard Kubernetes condition types.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+func (s *System) GetReconciled() bool {
+	return s.Status.Reconciled
+}
+
+func (s *System) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+func (s *System) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func (s *System) SetStatusPlan(plan *PlanStatus) {
+	s.Status.Plan = plan
+}
+
+func (s *System) GetStatusPlan() *PlanStatus {
+	return s.Status.Plan
 }
 
 func (i *System) GetStrategyRequired() string {
@@ -483,6 +763,8 @@ func (s *System) SetAnnotations(annotations map[string]string) {
 // +kubebuilder:printcolumn:name="insync",type="boolean",JSONPath=".status.inSync",description="The current synchronization state."
 // +kubebuilder:printcolumn:name="scope",type="string",JSONPath=".status.deploymentScope",description="The current deploymentScope state."
 // +kubebuilder:printcolumn:name="reconciled",type="boolean",JSONPath=".status.reconciled",description="The current reconciliation state."
+// +kubebuilder:printcolumn:name="strategy",type="string",JSONPath=".status.strategy.state",description="The current strategy state.",priority=1
+// +kubebuilder:printcolumn:name="progress",type="integer",JSONPath=".status.strategy.completionPercentage",description="The completion percentage of the current strategy phase.",priority=1
 // +TODO(ecandotti): enhance docs/playbooks/wind-river-cloud-platform-deployment-manager.yaml#L431 since it's looking for the last column to get 'reconciled' value.
 type System struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -500,6 +782,23 @@ func (s *System) GetStatusDelta() string {
 	return s.Status.Delta
 }
 
+func (s *System) SetStatusDeltaEntries(entries []DeltaEntry, truncated bool) {
+	s.Status.DeltaEntries = entries
+	s.Status.DeltaTruncated = truncated
+}
+
+func (s *System) GetStatusDeltaEntries() []DeltaEntry {
+	return s.Status.DeltaEntries
+}
+
+func (s *System) SetStatusMonitor(monitor *MonitorStatus) {
+	s.Status.Monitor = monitor
+}
+
+func (s *System) GetStatusMonitor() *MonitorStatus {
+	return s.Status.Monitor
+}
+
 func (s *System) GetInsync() bool {
 	return s.Status.InSync
 }
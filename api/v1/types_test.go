@@ -5,6 +5,7 @@ package v1
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/clusters"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
@@ -229,3 +230,136 @@ var _ = Describe("PtpInstanceSpec", func() {
 		})
 	})
 })
+
+var _ = Describe("MaintenanceInfo", func() {
+	// Monday, October 12, 2026
+	monday := time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)
+
+	Describe("IsOpen", func() {
+		It("should always be open when no windows are defined", func() {
+			var info *MaintenanceInfo
+			open, next, err := info.IsOpen(monday)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("should be open inside a daily window and closed outside", func() {
+			info := &MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{{Start: "02:00", Duration: "4h"}},
+			}
+
+			open, _, err := info.IsOpen(monday.Add(3 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+
+			open, next, err := info.IsOpen(monday.Add(7 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeFalse())
+			Expect(next).To(Equal(monday.AddDate(0, 0, 1).Add(2 * time.Hour)))
+		})
+
+		It("should honour windows which extend past midnight", func() {
+			info := &MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{
+					{Days: []string{"sunday"}, Start: "22:00", Duration: "4h"},
+				},
+			}
+
+			open, _, err := info.IsOpen(monday.Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+
+			open, next, err := info.IsOpen(monday.Add(3 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeFalse())
+			Expect(next).To(Equal(monday.AddDate(0, 0, 6).Add(22 * time.Hour)))
+		})
+
+		It("should evaluate windows in the configured timezone", func() {
+			timezone := "America/Toronto"
+			info := &MaintenanceInfo{
+				Timezone: &timezone,
+				Windows:  []MaintenanceWindowInfo{{Start: "02:00", Duration: "1h"}},
+			}
+
+			// 02:30 in Toronto is 06:30 UTC during daylight saving time.
+			open, _, err := info.IsOpen(monday.Add(6*time.Hour + 30*time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeTrue())
+
+			open, _, err = info.IsOpen(monday.Add(2*time.Hour + 30*time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(BeFalse())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("should accept well formed windows", func() {
+			info := &MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{
+					{Days: []string{"saturday", "sunday"}, Start: "23:30", Duration: "1h30m"},
+				},
+			}
+			Expect(info.Validate()).To(Succeed())
+		})
+
+		It("should reject malformed windows", func() {
+			timezone := "Nowhere/Special"
+			Expect((&MaintenanceInfo{Timezone: &timezone,
+				Windows: []MaintenanceWindowInfo{{Start: "02:00", Duration: "1h"}}}).Validate()).ToNot(Succeed())
+			Expect((&MaintenanceInfo{}).Validate()).ToNot(Succeed())
+			Expect((&MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{{Start: "24:00", Duration: "1h"}}}).Validate()).ToNot(Succeed())
+			Expect((&MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{{Start: "02:00", Duration: "200h"}}}).Validate()).ToNot(Succeed())
+			Expect((&MaintenanceInfo{
+				Windows: []MaintenanceWindowInfo{{Days: []string{"someday"}, Start: "02:00", Duration: "1h"}}}).Validate()).ToNot(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Deployment scope", func() {
+	Describe("lastAppliedDeploymentScope", func() {
+		It("should return the deploymentScope status attribute", func() {
+			scope, err := lastAppliedDeploymentScope(`{"status":{"deploymentScope":"principal"}}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scope).To(Equal(ScopePrincipal))
+		})
+
+		It("should return an error for invalid JSON", func() {
+			_, err := lastAppliedDeploymentScope(`{"status":{"deploymentScope":"principal"`)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an empty scope if the attribute or status is missing", func() {
+			for _, config := range []string{`{"status":{}}`, `{}`} {
+				scope, err := lastAppliedDeploymentScope(config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scope).To(BeEmpty())
+			}
+		})
+	})
+
+	Describe("GetDeclaredDeploymentScope", func() {
+		It("should report where the scope was found", func() {
+			host := &Host{}
+			scope, source, err := GetDeclaredDeploymentScope(host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scope).To(Equal(ScopeBootstrap))
+			Expect(source).To(Equal(ScopeSourceDefault))
+
+			host.Annotations = map[string]string{LastAppliedConfigKey: `{"status":{"deploymentScope":"Principal"}}`}
+			scope, source, err = GetDeclaredDeploymentScope(host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scope).To(Equal(ScopePrincipal))
+			Expect(source).To(Equal(ScopeSourceLastApplied))
+
+			host.Annotations[DeploymentScopeKey] = ScopeBootstrap
+			scope, source, err = GetDeclaredDeploymentScope(host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scope).To(Equal(ScopeBootstrap))
+			Expect(source).To(Equal(ScopeSourceAnnotation))
+		})
+	})
+})
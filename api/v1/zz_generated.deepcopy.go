@@ -8,6 +8,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -148,6 +149,13 @@ func (in *AddressPoolStatus) DeepCopyInto(out *AddressPoolStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressPoolStatus.
@@ -585,6 +593,13 @@ func (in *DataNetworkStatus) DeepCopyInto(out *DataNetworkStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataNetworkStatus.
@@ -597,6 +612,21 @@ func (in *DataNetworkStatus) DeepCopy() *DataNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeltaEntry) DeepCopyInto(out *DeltaEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeltaEntry.
+func (in *DeltaEntry) DeepCopy() *DeltaEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DeltaEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ErrMissingSystemResource) DeepCopyInto(out *ErrMissingSystemResource) {
 	*out = *in
@@ -774,6 +804,7 @@ func (in *HostProfile) DeepCopyInto(out *HostProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfile.
@@ -888,6 +919,28 @@ func (in *HostProfileSpec) DeepCopy() *HostProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProfileStatus) DeepCopyInto(out *HostProfileStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProfileStatus.
+func (in *HostProfileStatus) DeepCopy() *HostProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostSpec) DeepCopyInto(out *HostSpec) {
 	*out = *in
@@ -901,6 +954,11 @@ func (in *HostSpec) DeepCopyInto(out *HostSpec) {
 		*out = new(HostProfileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSpec.
@@ -941,6 +999,33 @@ func (in *HostStatus) DeepCopyInto(out *HostStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeltaEntries != nil {
+		in, out := &in.DeltaEntries, &out.DeltaEntries
+		*out = make([]DeltaEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(MonitorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStatus.
@@ -1051,6 +1136,72 @@ func (in *MatchBMInfo) DeepCopy() *MatchBMInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceInfo) DeepCopyInto(out *MaintenanceInfo) {
+	*out = *in
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindowInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceInfo.
+func (in *MaintenanceInfo) DeepCopy() *MaintenanceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowInfo) DeepCopyInto(out *MaintenanceWindowInfo) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowInfo.
+func (in *MaintenanceWindowInfo) DeepCopy() *MaintenanceWindowInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.NextWindow != nil {
+		in, out := &in.NextWindow, &out.NextWindow
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatchDMIInfo) DeepCopyInto(out *MatchDMIInfo) {
 	*out = *in
@@ -1201,6 +1352,25 @@ func (in *MonitorInfo) DeepCopy() *MonitorInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
+func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in NTPServerList) DeepCopyInto(out *NTPServerList) {
 	{
@@ -1337,6 +1507,45 @@ func (in PhysicalVolumeList) DeepCopy() PhysicalVolumeList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
+	*out = *in
+	if in.GeneratedAt != nil {
+		in, out := &in.GeneratedAt, &out.GeneratedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]PlannedRequest, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedRequest) DeepCopyInto(out *PlannedRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedRequest.
+func (in *PlannedRequest) DeepCopy() *PlannedRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlatformNetwork) DeepCopyInto(out *PlatformNetwork) {
 	*out = *in
@@ -1443,6 +1652,18 @@ func (in *PlatformNetworkStatus) DeepCopyInto(out *PlatformNetworkStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkStatus.
@@ -1793,6 +2014,18 @@ func (in *PtpInstanceStatus) DeepCopyInto(out *PtpInstanceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInstanceStatus.
@@ -1911,6 +2144,18 @@ func (in *PtpInterfaceStatus) DeepCopyInto(out *PtpInterfaceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PtpInterfaceStatus.
@@ -2066,6 +2311,104 @@ func (in StorageBackendList) DeepCopy() StorageBackendList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrategyHistoryEntry) DeepCopyInto(out *StrategyHistoryEntry) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]StrategyTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyHistoryEntry.
+func (in *StrategyHistoryEntry) DeepCopy() *StrategyHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(StrategyHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrategyInfo) DeepCopyInto(out *StrategyInfo) {
+	*out = *in
+	if in.AlarmRestrictions != nil {
+		in, out := &in.AlarmRestrictions, &out.AlarmRestrictions
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerApplyType != nil {
+		in, out := &in.WorkerApplyType, &out.WorkerApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageApplyType != nil {
+		in, out := &in.StorageApplyType, &out.StorageApplyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxParallelWorkers != nil {
+		in, out := &in.MaxParallelWorkers, &out.MaxParallelWorkers
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultInstanceAction != nil {
+		in, out := &in.DefaultInstanceAction, &out.DefaultInstanceAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApprovalMode != nil {
+		in, out := &in.ApprovalMode, &out.ApprovalMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRetryInterval != nil {
+		in, out := &in.MaxRetryInterval, &out.MaxRetryInterval
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyInfo.
+func (in *StrategyInfo) DeepCopy() *StrategyInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(StrategyInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrategyTrigger) DeepCopyInto(out *StrategyTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyTrigger.
+func (in *StrategyTrigger) DeepCopy() *StrategyTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(StrategyTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *System) DeepCopyInto(out *System) {
 	*out = *in
@@ -2195,6 +2538,16 @@ func (in *SystemSpec) DeepCopyInto(out *SystemSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(StrategyInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemSpec.
@@ -2215,6 +2568,45 @@ func (in *SystemStatus) DeepCopyInto(out *SystemStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeltaEntries != nil {
+		in, out := &in.DeltaEntries, &out.DeltaEntries
+		*out = make([]DeltaEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(SystemStrategyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StrategyHistory != nil {
+		in, out := &in.StrategyHistory, &out.StrategyHistory
+		*out = make([]StrategyHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(MonitorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStatus.
@@ -2227,6 +2619,34 @@ func (in *SystemStatus) DeepCopy() *SystemStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemStrategyStatus) DeepCopyInto(out *SystemStrategyStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]StrategyTrigger, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemStrategyStatus.
+func (in *SystemStrategyStatus) DeepCopy() *SystemStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemStorageInfo) DeepCopyInto(out *SystemStorageInfo) {
 	*out = *in
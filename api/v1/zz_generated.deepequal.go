@@ -138,6 +138,23 @@ func (in *AddressPoolStatus) DeepEqual(other *AddressPoolStatus) bool {
 		return false
 	}
 
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -674,6 +691,46 @@ func (in *DataNetworkStatus) DeepEqual(other *DataNetworkStatus) bool {
 		return false
 	}
 
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *DeltaEntry) DeepEqual(other *DeltaEntry) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Op != other.Op {
+		return false
+	}
+	if in.Desired != other.Desired {
+		return false
+	}
+	if in.Observed != other.Observed {
+		return false
+	}
+
 	return true
 }
 
@@ -943,6 +1000,33 @@ func (in *HostProfileSpec) DeepEqual(other *HostProfileSpec) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HostProfileStatus) DeepEqual(other *HostProfileStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *HostSpec) DeepEqual(other *HostSpec) bool {
@@ -969,6 +1053,14 @@ func (in *HostSpec) DeepEqual(other *HostSpec) bool {
 		}
 	}
 
+	if (in.Maintenance == nil) != (other.Maintenance == nil) {
+		return false
+	} else if in.Maintenance != nil {
+		if !in.Maintenance.DeepEqual(other.Maintenance) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1046,6 +1138,65 @@ func (in *HostStatus) DeepEqual(other *HostStatus) bool {
 	if in.Delta != other.Delta {
 		return false
 	}
+	if ((in.DeltaEntries != nil) && (other.DeltaEntries != nil)) || ((in.DeltaEntries == nil) != (other.DeltaEntries == nil)) {
+		in, other := &in.DeltaEntries, &other.DeltaEntries
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.DeltaTruncated != other.DeltaTruncated {
+		return false
+	}
+	if (in.Maintenance == nil) != (other.Maintenance == nil) {
+		return false
+	} else if in.Maintenance != nil {
+		if !in.Maintenance.DeepEqual(other.Maintenance) {
+			return false
+		}
+	}
+	if (in.Monitor == nil) != (other.Monitor == nil) {
+		return false
+	} else if in.Monitor != nil {
+		if !in.Monitor.DeepEqual(other.Monitor) {
+			return false
+		}
+	}
+
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
 
 	return true
 }
@@ -1165,6 +1316,92 @@ func (in *MatchBMInfo) DeepEqual(other *MatchBMInfo) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MaintenanceInfo) DeepEqual(other *MaintenanceInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.Timezone == nil) != (other.Timezone == nil) {
+		return false
+	} else if in.Timezone != nil {
+		if *in.Timezone != *other.Timezone {
+			return false
+		}
+	}
+
+	if ((in.Windows != nil) && (other.Windows != nil)) || ((in.Windows == nil) != (other.Windows == nil)) {
+		in, other := &in.Windows, &other.Windows
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MaintenanceWindowInfo) DeepEqual(other *MaintenanceWindowInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Days != nil) && (other.Days != nil)) || ((in.Days == nil) != (other.Days == nil)) {
+		in, other := &in.Days, &other.Days
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Start != other.Start {
+		return false
+	}
+	if in.Duration != other.Duration {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepEqual(other *MaintenanceWindowStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Pending != other.Pending {
+		return false
+	}
+	if !in.NextWindow.Equal(other.NextWindow) {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *MatchDMIInfo) DeepEqual(other *MatchDMIInfo) bool {
@@ -1339,6 +1576,38 @@ func (in *MonitorInfo) DeepEqual(other *MonitorInfo) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MonitorStatus) DeepEqual(other *MonitorStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Message != other.Message {
+		return false
+	}
+	if in.Interval != other.Interval {
+		return false
+	}
+	if !in.StartTime.Equal(other.StartTime) {
+		return false
+	}
+	if in.Deadline != other.Deadline {
+		return false
+	}
+	if in.DeadlineExceeded != other.DeadlineExceeded {
+		return false
+	}
+	if in.LastError != other.LastError {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *NTPServerList) DeepEqual(other *NTPServerList) bool {
@@ -1524,42 +1793,102 @@ func (in *PhysicalVolumeList) DeepEqual(other *PhysicalVolumeList) bool {
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+func (in *PlanStatus) DeepEqual(other *PlanStatus) bool {
 	if other == nil {
 		return false
 	}
 
-	if len(*in) != len(*other) {
+	if in.ObservedGeneration != other.ObservedGeneration {
 		return false
-	} else {
-		for _, inElement := range *in {
-			found := false
-			for _, otherElement := range *other {
-				if inElement == otherElement {
-					found = true
-					break
+	}
+	if !in.GeneratedAt.Equal(other.GeneratedAt) {
+		return false
+	}
+	if ((in.Requests != nil) && (other.Requests != nil)) || ((in.Requests == nil) != (other.Requests == nil)) {
+		in, other := &in.Requests, &other.Requests
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
 				}
 			}
-			if !found {
-				return false
-			}
 		}
 	}
 
+	if in.Truncated != other.Truncated {
+		return false
+	}
+	if in.Error != other.Error {
+		return false
+	}
+
 	return true
 }
 
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
-func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
+func (in *PlannedRequest) DeepEqual(other *PlannedRequest) bool {
 	if other == nil {
 		return false
 	}
 
-	if in.Type != other.Type {
+	if in.Method != other.Method {
 		return false
 	}
-	if in.Dynamic != other.Dynamic {
+	if in.Path != other.Path {
+		return false
+	}
+	if in.Body != other.Body {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkItemList) DeepEqual(other *PlatformNetworkItemList) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for _, inElement := range *in {
+			found := false
+			for _, otherElement := range *other {
+				if inElement == otherElement {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PlatformNetworkSpec) DeepEqual(other *PlatformNetworkSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Type != other.Type {
+		return false
+	}
+	if in.Dynamic != other.Dynamic {
 		return false
 	}
 	if ((in.AssociatedAddressPools != nil) && (other.AssociatedAddressPools != nil)) || ((in.AssociatedAddressPools == nil) != (other.AssociatedAddressPools == nil)) {
@@ -1616,6 +1945,31 @@ func (in *PlatformNetworkStatus) DeepEqual(other *PlatformNetworkStatus) bool {
 		return false
 	}
 
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2054,6 +2408,31 @@ func (in *PtpInstanceStatus) DeepEqual(other *PtpInstanceStatus) bool {
 		return false
 	}
 
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2151,6 +2530,31 @@ func (in *PtpInterfaceStatus) DeepEqual(other *PtpInterfaceStatus) bool {
 		return false
 	}
 
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2371,6 +2775,165 @@ func (in *StorageBackendList) DeepEqual(other *StorageBackendList) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *StrategyHistoryEntry) DeepEqual(other *StrategyHistoryEntry) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.ID != other.ID {
+		return false
+	}
+	if in.Outcome != other.Outcome {
+		return false
+	}
+	if in.FailureReason != other.FailureReason {
+		return false
+	}
+	if ((in.Triggers != nil) && (other.Triggers != nil)) || ((in.Triggers == nil) != (other.Triggers == nil)) {
+		in, other := &in.Triggers, &other.Triggers
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if !in.StartTime.Equal(other.StartTime) {
+		return false
+	}
+	if !in.FinishTime.Equal(other.FinishTime) {
+		return false
+	}
+	if in.Duration != other.Duration {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *StrategyInfo) DeepEqual(other *StrategyInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.AlarmRestrictions != nil {
+		if (in.AlarmRestrictions == nil) != (other.AlarmRestrictions == nil) {
+			return false
+		} else if in.AlarmRestrictions != nil {
+			if *in.AlarmRestrictions != *other.AlarmRestrictions {
+				return false
+			}
+		}
+	}
+
+	if in.WorkerApplyType != nil {
+		if (in.WorkerApplyType == nil) != (other.WorkerApplyType == nil) {
+			return false
+		} else if in.WorkerApplyType != nil {
+			if *in.WorkerApplyType != *other.WorkerApplyType {
+				return false
+			}
+		}
+	}
+
+	if in.StorageApplyType != nil {
+		if (in.StorageApplyType == nil) != (other.StorageApplyType == nil) {
+			return false
+		} else if in.StorageApplyType != nil {
+			if *in.StorageApplyType != *other.StorageApplyType {
+				return false
+			}
+		}
+	}
+
+	if in.MaxParallelWorkers != nil {
+		if (in.MaxParallelWorkers == nil) != (other.MaxParallelWorkers == nil) {
+			return false
+		} else if in.MaxParallelWorkers != nil {
+			if *in.MaxParallelWorkers != *other.MaxParallelWorkers {
+				return false
+			}
+		}
+	}
+
+	if in.DefaultInstanceAction != nil {
+		if (in.DefaultInstanceAction == nil) != (other.DefaultInstanceAction == nil) {
+			return false
+		} else if in.DefaultInstanceAction != nil {
+			if *in.DefaultInstanceAction != *other.DefaultInstanceAction {
+				return false
+			}
+		}
+	}
+
+	if in.ApprovalMode != nil {
+		if (in.ApprovalMode == nil) != (other.ApprovalMode == nil) {
+			return false
+		} else if in.ApprovalMode != nil {
+			if *in.ApprovalMode != *other.ApprovalMode {
+				return false
+			}
+		}
+	}
+
+	if in.MaxRetries != nil {
+		if (in.MaxRetries == nil) != (other.MaxRetries == nil) {
+			return false
+		} else if in.MaxRetries != nil {
+			if *in.MaxRetries != *other.MaxRetries {
+				return false
+			}
+		}
+	}
+
+	if in.MaxRetryInterval != nil {
+		if (in.MaxRetryInterval == nil) != (other.MaxRetryInterval == nil) {
+			return false
+		} else if in.MaxRetryInterval != nil {
+			if *in.MaxRetryInterval != *other.MaxRetryInterval {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *StrategyTrigger) DeepEqual(other *StrategyTrigger) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Kind != other.Kind {
+		return false
+	}
+	if in.Name != other.Name {
+		return false
+	}
+	if in.Personality != other.Personality {
+		return false
+	}
+	if in.StrategyRequired != other.StrategyRequired {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
@@ -2496,6 +3059,26 @@ func (in *SystemSpec) DeepEqual(other *SystemSpec) bool {
 		}
 	}
 
+	if in.Strategy != nil {
+		if (in.Strategy == nil) != (other.Strategy == nil) {
+			return false
+		} else if in.Strategy != nil {
+			if !in.Strategy.DeepEqual(other.Strategy) {
+				return false
+			}
+		}
+	}
+
+	if in.Maintenance != nil {
+		if (in.Maintenance == nil) != (other.Maintenance == nil) {
+			return false
+		} else if in.Maintenance != nil {
+			if !in.Maintenance.DeepEqual(other.Maintenance) {
+				return false
+			}
+		}
+	}
+
 	return true
 }
 
@@ -2547,12 +3130,156 @@ func (in *SystemStatus) DeepEqual(other *SystemStatus) bool {
 	if in.Delta != other.Delta {
 		return false
 	}
+	if ((in.DeltaEntries != nil) && (other.DeltaEntries != nil)) || ((in.DeltaEntries == nil) != (other.DeltaEntries == nil)) {
+		in, other := &in.DeltaEntries, &other.DeltaEntries
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.DeltaTruncated != other.DeltaTruncated {
+		return false
+	}
 	if in.StrategyApplied != other.StrategyApplied {
 		return false
 	}
 	if in.StrategyRetryCount != other.StrategyRetryCount {
 		return false
 	}
+	if (in.Strategy == nil) != (other.Strategy == nil) {
+		return false
+	} else if in.Strategy != nil {
+		if !in.Strategy.DeepEqual(other.Strategy) {
+			return false
+		}
+	}
+
+	if ((in.StrategyHistory != nil) && (other.StrategyHistory != nil)) || ((in.StrategyHistory == nil) != (other.StrategyHistory == nil)) {
+		in, other := &in.StrategyHistory, &other.StrategyHistory
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if (in.Maintenance == nil) != (other.Maintenance == nil) {
+		return false
+	} else if in.Maintenance != nil {
+		if !in.Maintenance.DeepEqual(other.Maintenance) {
+			return false
+		}
+	}
+	if (in.Monitor == nil) != (other.Monitor == nil) {
+		return false
+	} else if in.Monitor != nil {
+		if !in.Monitor.DeepEqual(other.Monitor) {
+			return false
+		}
+	}
+
+	if (in.Plan == nil) != (other.Plan == nil) {
+		return false
+	} else if in.Plan != nil {
+		if !in.Plan.DeepEqual(other.Plan) {
+			return false
+		}
+	}
+
+	if ((in.Conditions != nil) && (other.Conditions != nil)) || ((in.Conditions == nil) != (other.Conditions == nil)) {
+		in, other := &in.Conditions, &other.Conditions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *SystemStrategyStatus) DeepEqual(other *SystemStrategyStatus) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.ID != other.ID {
+		return false
+	}
+	if in.State != other.State {
+		return false
+	}
+	if in.Phase != other.Phase {
+		return false
+	}
+	if in.CurrentStage != other.CurrentStage {
+		return false
+	}
+	if in.TotalStages != other.TotalStages {
+		return false
+	}
+	if in.StageName != other.StageName {
+		return false
+	}
+	if in.CompletionPercentage != other.CompletionPercentage {
+		return false
+	}
+	if in.FailureReason != other.FailureReason {
+		return false
+	}
+	if in.PendingApproval != other.PendingApproval {
+		return false
+	}
+	if !in.StartTime.Equal(other.StartTime) {
+		return false
+	}
+	if !in.FinishTime.Equal(other.FinishTime) {
+		return false
+	}
+	if ((in.Triggers != nil) && (other.Triggers != nil)) || ((in.Triggers == nil) != (other.Triggers == nil)) {
+		in, other := &in.Triggers, &other.Triggers
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
 
 	return true
 }
@@ -0,0 +1,276 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// BatchEndpoint describes a single system to be extracted as part of a batch
+// build.  Credentials are resolved by looking first at the attributes set
+// directly on the endpoint, then at the openrc style credentials file, and
+// finally at the environment of the process.
+type BatchEndpoint struct {
+	// Name is the name of the system resource and of its output file.
+	Name string `json:"name"`
+
+	// Namespace is the namespace used to contain the system.
+	Namespace string `json:"namespace,omitempty"`
+
+	// AuthURL is the keystone URL of the system (i.e., OS_AUTH_URL).
+	AuthURL string `json:"authURL,omitempty"`
+
+	// Region is the region of the system API endpoint (i.e., OS_REGION_NAME).
+	Region string `json:"region,omitempty"`
+
+	// Interface is the system API endpoint interface (i.e., OS_INTERFACE).
+	Interface string `json:"interface,omitempty"`
+
+	// Credentials is the path to an openrc style file which sets the OS_*
+	// variables used to authenticate with the system.
+	Credentials string `json:"credentials,omitempty"`
+
+	// PasswordEnv is the name of an environment variable which holds the
+	// password of the system.
+	PasswordEnv string `json:"passwordEnv,omitempty"`
+
+	// Snapshot is the path to a snapshot captured with the capture subcommand
+	// to be used instead of accessing the running system.
+	Snapshot string `json:"snapshot,omitempty"`
+}
+
+// BatchConfig is the list of systems to be extracted in a batch build.
+type BatchConfig struct {
+	Endpoints []BatchEndpoint `json:"endpoints"`
+}
+
+// ParseBatchConfig parses a YAML batch endpoint list.  Unknown attributes
+// are rejected so that typos are not silently ignored.  Endpoints which do not
+// specify a namespace are assigned the default namespace.  Since the name of
+// each endpoint is used as the resource name and as the name of its output
+// files, names must be unique valid DNS-1123 subdomains.
+func ParseBatchConfig(data []byte, namespace string) (*BatchConfig, error) {
+	buf, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse batch endpoints")
+		return nil, err
+	}
+
+	config := BatchConfig{}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&config)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to decode batch endpoints")
+		return nil, err
+	}
+
+	if len(config.Endpoints) == 0 {
+		return nil, fmt.Errorf("batch endpoints must contain at least one endpoint")
+	}
+
+	names := make(map[string]bool)
+	for i := range config.Endpoints {
+		e := &config.Endpoints[i]
+		if e.Name == "" {
+			return nil, fmt.Errorf("batch endpoint %d must have a name", i)
+		} else if errs := validation.IsDNS1123Subdomain(e.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("batch endpoint name %q is invalid: %s",
+				e.Name, strings.Join(errs, ", "))
+		} else if names[e.Name] {
+			return nil, fmt.Errorf("batch endpoint name %q is not unique", e.Name)
+		}
+
+		names[e.Name] = true
+
+		if e.Namespace == "" {
+			e.Namespace = namespace
+		}
+
+		if e.Namespace == "" {
+			return nil, fmt.Errorf("batch endpoint %q must have a namespace", e.Name)
+		} else if errs := validation.IsDNS1123Label(e.Namespace); len(errs) > 0 {
+			return nil, fmt.Errorf("batch endpoint %q namespace %q is invalid: %s",
+				e.Name, e.Namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	return &config, nil
+}
+
+// ParseOpenRC extracts the variable assignments from an openrc style shell
+// script.  Lines which are not simple assignments, and values which rely on
+// command substitution, are skipped so that those variables can be supplied
+// by some other means.
+func ParseOpenRC(data []byte) (map[string]string, error) {
+	result := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		key, value, found := strings.Cut(line, "=")
+		if !found || key == "" || strings.ContainsAny(key, " \t$") {
+			continue
+		}
+
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		} else if strings.ContainsAny(value, " \t") {
+			continue
+		}
+
+		if strings.Contains(value, "`") || strings.Contains(value, "$(") {
+			continue
+		}
+
+		result[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		err = perrors.Wrap(err, "failed to read openrc data")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CredentialLookup returns a function which resolves the OS_* variables of
+// the endpoint.  Values which are not set on the endpoint or in its
+// credentials file are resolved with the fallback function.
+func (e *BatchEndpoint) CredentialLookup(fallback func(string) string) (func(string) string, error) {
+	values := make(map[string]string)
+
+	if e.Credentials != "" {
+		data, err := os.ReadFile(e.Credentials)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to read credentials file")
+			return nil, err
+		}
+
+		values, err = ParseOpenRC(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	overrides := map[string]string{
+		manager.AuthUrlKey:    e.AuthURL,
+		manager.RegionNameKey: e.Region,
+		manager.InterfaceKey:  e.Interface,
+	}
+
+	if e.PasswordEnv != "" {
+		overrides[manager.PasswordKey] = fallback(e.PasswordEnv)
+	}
+
+	for key, value := range overrides {
+		if value != "" {
+			values[key] = value
+		}
+	}
+
+	return func(key string) string {
+		if value, ok := values[key]; ok {
+			return value
+		}
+		return fallback(key)
+	}, nil
+}
+
+// BatchResult records the outcome of the build of a single batch endpoint.
+type BatchResult struct {
+	Name              string
+	Namespace         string
+	Output            string
+	IncompleteSecrets int
+	Duration          time.Duration
+	Err               error
+}
+
+// RunBatch runs the build function against each of the endpoints with at
+// most parallelism builds in progress at any one time.  The results are
+// returned in the same order as the endpoints.
+func RunBatch(endpoints []BatchEndpoint, parallelism int, fn func(BatchEndpoint) BatchResult) []BatchResult {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]BatchResult, len(endpoints))
+	tokens := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		tokens <- struct{}{}
+
+		go func(i int, e BatchEndpoint) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+
+			start := time.Now()
+			result := fn(e)
+			result.Duration = time.Since(start)
+			results[i] = result
+		}(i, e)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// WriteBatchSummary writes a table summarizing the outcome of each of the
+// builds of a batch.
+func WriteBatchSummary(w io.Writer, results []BatchResult) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	_, _ = fmt.Fprintf(tw, "SYSTEM\tNAMESPACE\tSTATUS\tINCOMPLETE SECRETS\tDURATION\tDETAILS\n")
+
+	var failed, incomplete int
+	for _, r := range results {
+		status := "ok"
+		details := r.Output
+		if r.Err != nil {
+			status = "failed"
+			details = r.Err.Error()
+			failed++
+		} else if r.IncompleteSecrets > 0 {
+			status = "incomplete"
+			incomplete++
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\n",
+			r.Name, r.Namespace, status, r.IncompleteSecrets,
+			r.Duration.Round(time.Second), details)
+	}
+
+	_, _ = fmt.Fprintf(tw, "\n%d system(s): %d succeeded, %d failed, %d with incomplete secrets\n",
+		len(results), len(results)-failed, failed, incomplete)
+
+	return tw.Flush()
+}
@@ -0,0 +1,165 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+)
+
+var _ = Describe("Batch build utilities", func() {
+	Describe("ParseBatchConfig", func() {
+		It("should parse a list of endpoints", func() {
+			config, err := ParseBatchConfig([]byte(`
+endpoints:
+- name: subcloud1
+  namespace: site1
+  authURL: https://10.10.10.2:5000/v3
+  region: subcloud1
+  credentials: /home/sysadmin/openrc
+- name: subcloud2
+  snapshot: subcloud2.json
+`), "deployment")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Endpoints).To(Equal([]BatchEndpoint{
+				{
+					Name:        "subcloud1",
+					Namespace:   "site1",
+					AuthURL:     "https://10.10.10.2:5000/v3",
+					Region:      "subcloud1",
+					Credentials: "/home/sysadmin/openrc",
+				},
+				{Name: "subcloud2", Namespace: "deployment", Snapshot: "subcloud2.json"},
+			}))
+		})
+
+		It("should reject unknown attributes and duplicate names", func() {
+			_, err := ParseBatchConfig([]byte("endpoints:\n- name: a\n  regoin: b\n"), "deployment")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseBatchConfig([]byte("endpoints:\n- name: a\n- name: a\n"), "deployment")
+			Expect(err).To(MatchError(ContainSubstring("not unique")))
+
+			_, err = ParseBatchConfig([]byte("endpoints: []\n"), "deployment")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject missing and invalid names", func() {
+			_, err := ParseBatchConfig([]byte("endpoints:\n- namespace: site1\n"), "deployment")
+			Expect(err).To(MatchError(ContainSubstring("must have a name")))
+
+			for _, name := range []string{"../subcloud1", "sub/cloud1", "Subcloud1", "subcloud_1", ".."} {
+				_, err = ParseBatchConfig([]byte(fmt.Sprintf("endpoints:\n- name: %q\n", name)), "deployment")
+				Expect(err).To(MatchError(ContainSubstring("is invalid")), name)
+			}
+		})
+
+		It("should reject missing and invalid namespaces", func() {
+			_, err := ParseBatchConfig([]byte("endpoints:\n- name: subcloud1\n"), "")
+			Expect(err).To(MatchError(ContainSubstring("must have a namespace")))
+
+			_, err = ParseBatchConfig([]byte("endpoints:\n- name: subcloud1\n"), "Site1")
+			Expect(err).To(MatchError(ContainSubstring("is invalid")))
+
+			_, err = ParseBatchConfig([]byte("endpoints:\n- name: subcloud1\n  namespace: site.1\n"), "deployment")
+			Expect(err).To(MatchError(ContainSubstring("is invalid")))
+		})
+	})
+
+	Describe("CredentialLookup", func() {
+		It("should prefer endpoint attributes, then the credentials file, then the fallback", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "openrc")
+			Expect(os.WriteFile(filename, []byte(`# openrc
+unset OS_SERVICE_TOKEN
+export OS_USERNAME=admin
+export OS_PASSWORD=`+"`keyring get CGCS admin`"+`
+export OS_AUTH_URL="http://192.168.204.1:5000/v3"
+export OS_PROJECT_NAME='admin project'
+OS_REGION_NAME=RegionOne
+`), 0600)).To(Succeed())
+
+			endpoint := BatchEndpoint{
+				Name:        "subcloud1",
+				Region:      "subcloud1",
+				Credentials: filename,
+				PasswordEnv: "SUBCLOUD1_PASSWORD",
+			}
+
+			env := map[string]string{
+				"SUBCLOUD1_PASSWORD":   "s3cret",
+				manager.DomainNameKey:  "Default",
+				manager.InterfaceKey:   "internal",
+				manager.RegionNameKey:  "ignored",
+				manager.AuthUrlKey:     "ignored",
+				manager.UsernameKey:    "ignored",
+				manager.PasswordKey:    "ignored",
+				manager.ProjectNameKey: "ignored",
+			}
+
+			lookup, err := endpoint.CredentialLookup(func(key string) string { return env[key] })
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(lookup(manager.UsernameKey)).To(Equal("admin"))
+			Expect(lookup(manager.PasswordKey)).To(Equal("s3cret"))
+			Expect(lookup(manager.AuthUrlKey)).To(Equal("http://192.168.204.1:5000/v3"))
+			Expect(lookup(manager.ProjectNameKey)).To(Equal("admin project"))
+			Expect(lookup(manager.RegionNameKey)).To(Equal("subcloud1"))
+			Expect(lookup(manager.InterfaceKey)).To(Equal("internal"))
+			Expect(lookup(manager.DomainNameKey)).To(Equal("Default"))
+		})
+	})
+
+	Describe("RunBatch", func() {
+		It("should bound the number of concurrent builds and preserve ordering", func() {
+			endpoints := make([]BatchEndpoint, 8)
+			for i := range endpoints {
+				endpoints[i].Name = fmt.Sprintf("system-%d", i)
+			}
+
+			var running, peak int32
+			results := RunBatch(endpoints, 3, func(e BatchEndpoint) BatchResult {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				return BatchResult{Name: e.Name}
+			})
+
+			Expect(peak).To(BeNumerically("<=", 3))
+			Expect(results).To(HaveLen(len(endpoints)))
+			for i, r := range results {
+				Expect(r.Name).To(Equal(endpoints[i].Name))
+			}
+		})
+	})
+
+	Describe("WriteBatchSummary", func() {
+		It("should list the status of each system", func() {
+			buf := &bytes.Buffer{}
+			Expect(WriteBatchSummary(buf, []BatchResult{
+				{Name: "subcloud1", Namespace: "site1", Output: "out/subcloud1.yaml"},
+				{Name: "subcloud2", Namespace: "site2", Output: "out/subcloud2.yaml", IncompleteSecrets: 2},
+				{Name: "subcloud3", Namespace: "site3", Err: fmt.Errorf("failed to authenticate")},
+			})).To(Succeed())
+
+			Expect(buf.String()).To(MatchRegexp(`subcloud1\s+site1\s+ok\s+0\s+0s\s+out/subcloud1.yaml`))
+			Expect(buf.String()).To(MatchRegexp(`subcloud2\s+site2\s+incomplete\s+2`))
+			Expect(buf.String()).To(MatchRegexp(`subcloud3\s+site3\s+failed\s+0\s+0s\s+failed to authenticate`))
+			Expect(buf.String()).To(ContainSubstring("3 system(s): 2 succeeded, 1 failed, 1 with incomplete secrets"))
+		})
+	})
+})
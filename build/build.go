@@ -32,6 +32,14 @@ import (
 
 const yamlSeparator = "---\n"
 
+// IncompleteSecretWarning is the placeholder stored in each secret attribute
+// that could not be retrieved from the system.
+const IncompleteSecretWarning = "Warning: Incomplete secret, please replace it with the secret content"
+
+// incompleteSecretDataKey is the attribute name used for the placeholder of
+// secrets whose attribute names are not known.
+const incompleteSecretDataKey = "Fake Data"
+
 // Builder is the deployment builder interface which exists to allow easier
 // mocking for unit test development.
 type Builder interface {
@@ -40,6 +48,10 @@ type Builder interface {
 	AddProfileFilters(filters []ProfileFilter)
 	AddHostFilters(filters []HostFilter)
 	AddPlatformNetworkFilters(filters []PlatformNetworkFilter)
+	EnableProfileFactoring()
+	AddSecretSources(sources []SecretSource)
+	EnableReport()
+	Report() *BuildReport
 }
 
 // DeploymentBuilder is the concrete implementation of the builder interface
@@ -54,25 +66,37 @@ type DeploymentBuilder struct {
 	profileFilters         []ProfileFilter
 	hostFilters            []HostFilter
 	platformNetworkFilters []PlatformNetworkFilter
+	factorProfiles         bool
+	secretSources          []SecretSource
+	reporting              bool
+	report                 *BuildReport
 }
 
-var defaultSystemFilters = []SystemFilter{
-	NewServiceParametersSystemFilter(),
+// Some filters accumulate state while they run so each builder is given its own
+// set of default filter instances so that builders can be run concurrently.
+func defaultSystemFilters() []SystemFilter {
+	return []SystemFilter{
+		NewServiceParametersSystemFilter(),
+	}
 }
 
-var defaultHostFilters = []HostFilter{
-	NewController0Filter(),
-	NewLoopbackInterfaceFilter(),
-	NewLocationFilter(),
-	NewAddressFilter(),
-	NewBMAddressFilter(),
-	NewStorageMonitorFilter(),
-	NewInterfaceRemoveUuidFilter(),
-	NewHostKernelFilter(),
+func defaultHostFilters() []HostFilter {
+	return []HostFilter{
+		NewController0Filter(),
+		NewLoopbackInterfaceFilter(),
+		NewLocationFilter(),
+		NewAddressFilter(),
+		NewBMAddressFilter(),
+		NewStorageMonitorFilter(),
+		NewInterfaceRemoveUuidFilter(),
+		NewHostKernelFilter(),
+	}
 }
 
-var defaultPlatformNetworkFilters = []PlatformNetworkFilter{
-	NewAddressPoolFilter(),
+func defaultPlatformNetworkFilters() []PlatformNetworkFilter {
+	return []PlatformNetworkFilter{
+		NewAddressPoolFilter(),
+	}
 }
 
 // NewDeploymentBuilder returns an instantiation of a deployment builder
@@ -83,16 +107,16 @@ func NewDeploymentBuilder(client *gophercloud.ServiceClient, namespace string, n
 		namespace:              namespace,
 		name:                   name,
 		progressWriter:         progressWriter,
-		systemFilters:          defaultSystemFilters,
-		platformNetworkFilters: defaultPlatformNetworkFilters,
-		hostFilters:            defaultHostFilters}
+		systemFilters:          defaultSystemFilters(),
+		platformNetworkFilters: defaultPlatformNetworkFilters(),
+		hostFilters:            defaultHostFilters()}
 }
 
 // parseIncompleteSecret is a convenience unitilty function to parse an incompleteSecret
 // from v1.Secret to IncompleteSecret to add the warning message to the data of
 // secret to request the action from users.
 func parseIncompleteSecret(secret *v1.Secret) *IncompleteSecret {
-	warningMsg := "Warning: Incomplete secret, please replace it with the secret content"
+	warningMsg := IncompleteSecretWarning
 	if secret.Type == v1.SecretTypeTLS {
 		return &IncompleteSecret{
 			TypeMeta:   secret.TypeMeta,
@@ -124,18 +148,21 @@ func parseIncompleteSecret(secret *v1.Secret) *IncompleteSecret {
 		ObjectMeta: secret.ObjectMeta,
 		Type:       secret.Type,
 		Data: map[string]string{
-			"Fake Data": warningMsg,
+			incompleteSecretDataKey: warningMsg,
 		},
 	}
 }
 
 // IncompleteSecret defines a struct that contains a warning message in the secret
-// data if the secret is incomplete
+// data if the secret is incomplete.  Attributes supplied by a secret source are
+// stored as string data so that they are encoded by the API server like those
+// of any other secret.
 type IncompleteSecret struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	Type              v1.SecretType     `json:"type"`
 	Data              map[string]string `json:"data"`
+	StringData        map[string]string `json:"stringData,omitempty"`
 }
 
 // Deployment defines the structure used to store all of the details of a
@@ -184,11 +211,28 @@ func (db *DeploymentBuilder) AddPlatformNetworkFilters(filters []PlatformNetwork
 	db.platformNetworkFilters = append(db.platformNetworkFilters, filters...)
 }
 
+// AddSecretSources adds a list of secret sources to the set already present
+// on the builder.  Sources are consulted in the order in which they are added.
+func (db *DeploymentBuilder) AddSecretSources(sources []SecretSource) {
+	db.secretSources = append(db.secretSources, sources...)
+}
+
+// EnableProfileFactoring requests that the host profiles be reorganized into a
+// base/personality/host hierarchy once they have been simplified.
+func (db *DeploymentBuilder) EnableProfileFactoring() {
+	db.factorProfiles = true
+}
+
 // Build is the main method which produces a deployment object based on a
 // running system.
 func (db *DeploymentBuilder) Build() (*Deployment, error) {
 	deployment := Deployment{}
 
+	db.report = nil
+	if db.reporting {
+		db.report = db.newBuildReport()
+	}
+
 	db.progressUpdate("building deployment for system %q in namespace %q\n", db.name, db.namespace)
 
 	db.progressUpdate("building namespace configuration\n")
@@ -275,6 +319,28 @@ func (db *DeploymentBuilder) Build() (*Deployment, error) {
 		return nil, err
 	}
 
+	if db.factorProfiles {
+		db.progressUpdate("factoring profile configurations\n")
+
+		err = db.factorHostProfiles(&deployment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(db.secretSources) > 0 && len(deployment.IncompleteSecrets) > 0 {
+		db.progressUpdate("resolving incomplete secrets\n")
+
+		err = db.resolveIncompleteSecrets(&deployment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if db.report != nil {
+		db.finalizeReport(&deployment)
+	}
+
 	return &deployment, nil
 }
 
@@ -437,8 +503,11 @@ func (db *DeploymentBuilder) buildNamespace(d *Deployment) error {
 }
 
 func (db *DeploymentBuilder) filterSystem(system *starlingxv1.System, deployment *Deployment) error {
+	target := diffResource{kind: starlingxv1.KindSystem, name: system.Name, spec: &system.Spec}
 	for _, f := range db.systemFilters {
-		err := f.Filter(system, deployment)
+		err := db.runFilter(f, deployment, func() error {
+			return f.Filter(system, deployment)
+		}, target)
 		if err != nil {
 			return err
 		}
@@ -711,8 +780,14 @@ func isInterfaceInUse(ifname string, info *starlingxv1.InterfaceInfo) bool {
 }
 
 func (db *DeploymentBuilder) filterHost(profile *starlingxv1.HostProfile, host *starlingxv1.Host, deployment *Deployment) error {
+	targets := []diffResource{
+		{kind: starlingxv1.KindHostProfile, name: profile.Name, spec: &profile.Spec},
+		{kind: starlingxv1.KindHost, name: host.Name, spec: &host.Spec},
+	}
 	for _, f := range db.hostFilters {
-		err := f.Filter(profile, host, deployment)
+		err := db.runFilter(f, deployment, func() error {
+			return f.Filter(profile, host, deployment)
+		}, targets...)
 		if err != nil {
 			return err
 		}
@@ -722,8 +797,11 @@ func (db *DeploymentBuilder) filterHost(profile *starlingxv1.HostProfile, host *
 }
 
 func (db *DeploymentBuilder) filterPlatformNetworks(platform_network *starlingxv1.PlatformNetwork, deployment *Deployment) error {
+	target := diffResource{kind: starlingxv1.KindPlatformNetwork, name: platform_network.Name, spec: &platform_network.Spec}
 	for _, f := range db.platformNetworkFilters {
-		err := f.Filter(platform_network, deployment)
+		err := db.runFilter(f, deployment, func() error {
+			return f.Filter(platform_network, deployment)
+		}, target)
 		if err != nil {
 			return err
 		}
@@ -733,8 +811,11 @@ func (db *DeploymentBuilder) filterPlatformNetworks(platform_network *starlingxv
 }
 
 func (db *DeploymentBuilder) filterHostProfile(profile *starlingxv1.HostProfile, deployment *Deployment) error {
+	target := diffResource{kind: starlingxv1.KindHostProfile, name: profile.Name, spec: &profile.Spec}
 	for _, f := range db.profileFilters {
-		err := f.Filter(profile, deployment)
+		err := db.runFilter(f, deployment, func() error {
+			return f.Filter(profile, deployment)
+		}, target)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,426 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+// DeltaOperation describes how a resource or an individual resource field
+// differs between the expected and the observed configuration.
+type DeltaOperation string
+
+const (
+	// DeltaAdded represents a resource or field which is present on the
+	// running system but absent from the deployment configuration.
+	DeltaAdded DeltaOperation = "added"
+
+	// DeltaRemoved represents a resource or field which is present in the
+	// deployment configuration but absent from the running system.
+	DeltaRemoved DeltaOperation = "removed"
+
+	// DeltaChanged represents a resource or field which is present in both
+	// but with different values.
+	DeltaChanged DeltaOperation = "changed"
+)
+
+// FieldDelta describes a single spec attribute that differs between the
+// expected and the observed configuration.
+type FieldDelta struct {
+	// Path is the dotted path to the attribute.  List elements that carry a
+	// name are identified by name, all others by index.
+	Path string `json:"path"`
+
+	// Operation describes how the attribute differs.
+	Operation DeltaOperation `json:"op"`
+
+	// Expected is the value found in the deployment configuration.
+	Expected interface{} `json:"expected,omitempty"`
+
+	// Observed is the value found on the running system.
+	Observed interface{} `json:"observed,omitempty"`
+}
+
+// ResourceDelta describes the differences found for a single resource.
+type ResourceDelta struct {
+	Kind      string         `json:"kind"`
+	Name      string         `json:"name"`
+	Operation DeltaOperation `json:"op"`
+	Fields    []FieldDelta   `json:"fields,omitempty"`
+}
+
+// diffResource is an intermediate representation of a resource that is
+// suitable for comparison regardless of its kind.
+type diffResource struct {
+	kind string
+	name string
+	spec interface{}
+}
+
+// diffKindOrder defines the order in which resource kinds are compared and
+// reported.  It follows the order in which resources are rendered by the
+// deployment builder.
+var diffKindOrder = []string{
+	starlingxv1.KindSystem,
+	starlingxv1.KindPlatformNetwork,
+	starlingxv1.KindAddressPool,
+	starlingxv1.KindDataNetwork,
+	starlingxv1.KindPTPInstance,
+	starlingxv1.KindPTPInterface,
+	starlingxv1.KindHostProfile,
+	starlingxv1.KindHost,
+}
+
+// diffResources returns the comparable resources of a deployment grouped by
+// kind and indexed by name.  Secrets are intentionally omitted since their
+// contents cannot be retrieved from the running system.
+func (d *Deployment) diffResources() map[string]map[string]diffResource {
+	result := make(map[string]map[string]diffResource)
+	for _, kind := range diffKindOrder {
+		result[kind] = make(map[string]diffResource)
+	}
+
+	add := func(kind, name string, spec interface{}) {
+		result[kind][name] = diffResource{kind: kind, name: name, spec: spec}
+	}
+
+	if d.System.Name != "" {
+		add(starlingxv1.KindSystem, d.System.Name, d.System.Spec)
+	}
+
+	for _, obj := range d.PlatformNetworks {
+		add(starlingxv1.KindPlatformNetwork, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.AddressPools {
+		add(starlingxv1.KindAddressPool, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.DataNetworks {
+		add(starlingxv1.KindDataNetwork, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.PtpInstances {
+		add(starlingxv1.KindPTPInstance, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.PtpInterfaces {
+		add(starlingxv1.KindPTPInterface, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.Profiles {
+		add(starlingxv1.KindHostProfile, obj.Name, obj.Spec)
+	}
+
+	for _, obj := range d.Hosts {
+		add(starlingxv1.KindHost, obj.Name, obj.Spec)
+	}
+
+	return result
+}
+
+// toGeneric converts a typed object to its generic JSON representation so
+// that it can be walked without knowledge of its concrete type.
+func toGeneric(obj interface{}) (interface{}, error) {
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	err = json.Unmarshal(buf, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// isEmptyValue determines whether a generic value should be treated the same
+// as an absent value.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// listElementKey returns the name of a list element if it has one.
+func listElementKey(value interface{}) (string, bool) {
+	if m, ok := value.(map[string]interface{}); ok {
+		if name, ok := m["name"].(string); ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// indexListByName indexes a list by the name of its elements.  If any element
+// does not have a name, or if names are not unique, then nil is returned.
+func indexListByName(list []interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(list))
+	for _, value := range list {
+		name, ok := listElementKey(value)
+		if !ok {
+			return nil
+		}
+
+		if _, present := result[name]; present {
+			return nil
+		}
+
+		result[name] = value
+	}
+
+	return result
+}
+
+// diffMaps compares two generic maps key by key.
+func diffMaps(path string, expected, observed map[string]interface{}) []FieldDelta {
+	keys := make(map[string]bool)
+	for k := range expected {
+		keys[k] = true
+	}
+	for k := range observed {
+		keys[k] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	result := make([]FieldDelta, 0)
+	for _, k := range sorted {
+		result = append(result, diffValues(path+"."+k, expected[k], observed[k])...)
+	}
+
+	return result
+}
+
+// diffLists compares two generic lists.  Lists of named elements are matched
+// by name so that reordering does not appear as drift, while all other lists
+// are compared element by element.
+func diffLists(path string, expected, observed []interface{}) []FieldDelta {
+	expectedByName := indexListByName(expected)
+	observedByName := indexListByName(observed)
+
+	if expectedByName != nil && observedByName != nil {
+		names := make(map[string]bool)
+		for name := range expectedByName {
+			names[name] = true
+		}
+		for name := range observedByName {
+			names[name] = true
+		}
+
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+
+		result := make([]FieldDelta, 0)
+		for _, name := range sorted {
+			elementPath := fmt.Sprintf("%s[name=%s]", path, name)
+			result = append(result, diffValues(elementPath, expectedByName[name], observedByName[name])...)
+		}
+
+		return result
+	}
+
+	count := len(expected)
+	if len(observed) > count {
+		count = len(observed)
+	}
+
+	result := make([]FieldDelta, 0)
+	for i := 0; i < count; i++ {
+		var e, o interface{}
+		if i < len(expected) {
+			e = expected[i]
+		}
+		if i < len(observed) {
+			o = observed[i]
+		}
+
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		result = append(result, diffValues(elementPath, e, o)...)
+	}
+
+	return result
+}
+
+// diffValues recursively compares two generic values and returns the list of
+// leaf attributes that differ.
+func diffValues(path string, expected, observed interface{}) []FieldDelta {
+	if e, ok := expected.(map[string]interface{}); ok {
+		if o, ok := observed.(map[string]interface{}); ok {
+			return diffMaps(path, e, o)
+		}
+	}
+
+	if e, ok := expected.([]interface{}); ok {
+		if o, ok := observed.([]interface{}); ok {
+			return diffLists(path, e, o)
+		}
+	}
+
+	expectedEmpty := isEmptyValue(expected)
+	observedEmpty := isEmptyValue(observed)
+
+	switch {
+	case expectedEmpty && observedEmpty:
+		return nil
+	case expectedEmpty:
+		return []FieldDelta{{Path: path, Operation: DeltaAdded, Observed: observed}}
+	case observedEmpty:
+		return []FieldDelta{{Path: path, Operation: DeltaRemoved, Expected: expected}}
+	}
+
+	if reflect.DeepEqual(expected, observed) {
+		return nil
+	}
+
+	return []FieldDelta{{Path: path, Operation: DeltaChanged, Expected: expected, Observed: observed}}
+}
+
+// genericResources converts the spec of each resource to its generic
+// representation so that the result is independent of later changes made to
+// the original resources.
+func genericResources(resources map[string]map[string]diffResource) (map[string]map[string]interface{}, error) {
+	result := make(map[string]map[string]interface{}, len(resources))
+	for kind, byName := range resources {
+		result[kind] = make(map[string]interface{}, len(byName))
+		for name, r := range byName {
+			spec, err := toGeneric(r.spec)
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to convert %s/%s", kind, name)
+				return nil, err
+			}
+
+			result[kind][name] = spec
+		}
+	}
+
+	return result, nil
+}
+
+// diffGenericResources compares two sets of generic resources.  Resources
+// are matched by kind and name.
+func diffGenericResources(expected, observed map[string]map[string]interface{}) []ResourceDelta {
+	result := make([]ResourceDelta, 0)
+	for _, kind := range diffKindOrder {
+		names := make(map[string]bool)
+		for name := range expected[kind] {
+			names[name] = true
+		}
+		for name := range observed[kind] {
+			names[name] = true
+		}
+
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+
+		for _, name := range sorted {
+			expectedSpec, inExpected := expected[kind][name]
+			observedSpec, inObserved := observed[kind][name]
+
+			if !inObserved {
+				result = append(result, ResourceDelta{Kind: kind, Name: name, Operation: DeltaRemoved})
+				continue
+			} else if !inExpected {
+				result = append(result, ResourceDelta{Kind: kind, Name: name, Operation: DeltaAdded})
+				continue
+			}
+
+			fields := diffValues("spec", expectedSpec, observedSpec)
+			if len(fields) > 0 {
+				result = append(result, ResourceDelta{
+					Kind:      kind,
+					Name:      name,
+					Operation: DeltaChanged,
+					Fields:    fields,
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// DiffDeployments compares the spec of every resource in the expected
+// deployment against its counterpart in the observed deployment.  Resources
+// are matched by kind and name.  The result is empty if no drift was found.
+func DiffDeployments(expected, observed *Deployment) ([]ResourceDelta, error) {
+	expectedResources, err := genericResources(expected.diffResources())
+	if err != nil {
+		err = perrors.Wrap(err, "failed to convert expected resources")
+		return nil, err
+	}
+
+	observedResources, err := genericResources(observed.diffResources())
+	if err != nil {
+		err = perrors.Wrap(err, "failed to convert observed resources")
+		return nil, err
+	}
+
+	return diffGenericResources(expectedResources, observedResources), nil
+}
+
+// formatDeltaValue renders a generic value on a single line.
+func formatDeltaValue(value interface{}) string {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(buf)
+}
+
+// DeltaToString renders a list of resource deltas in a human readable
+// format.  Similar to the delta reported in the resource status, lines
+// prefixed with "-" show the value observed on the running system and lines
+// prefixed with "+" show the value from the deployment configuration.
+func DeltaToString(deltas []ResourceDelta) string {
+	var b strings.Builder
+
+	for _, r := range deltas {
+		_, _ = fmt.Fprintf(&b, "%s/%s: %s\n", r.Kind, r.Name, r.Operation)
+
+		for _, f := range r.Fields {
+			_, _ = fmt.Fprintf(&b, "\t%s:\n", f.Path)
+
+			switch f.Operation {
+			case DeltaAdded:
+				_, _ = fmt.Fprintf(&b, "-\t\t%s\n", formatDeltaValue(f.Observed))
+			case DeltaRemoved:
+				_, _ = fmt.Fprintf(&b, "+\t\t%s\n", formatDeltaValue(f.Expected))
+			default:
+				_, _ = fmt.Fprintf(&b, "-\t\t%s\n", formatDeltaValue(f.Observed))
+				_, _ = fmt.Fprintf(&b, "+\t\t%s\n", formatDeltaValue(f.Expected))
+			}
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,141 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Diff utilities", func() {
+	newDeployment := func() *Deployment {
+		mtu := 1500
+		return &Deployment{
+			Namespace: v1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindNamespace},
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+			},
+			System: starlingxv1.System{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "starlingx.windriver.com/v1", Kind: starlingxv1.KindSystem},
+				ObjectMeta: metav1.ObjectMeta{Name: "vbox", Namespace: "deployment"},
+				Spec: starlingxv1.SystemSpec{
+					Description: &[]string{"lab"}[0],
+				},
+			},
+			Profiles: []*starlingxv1.HostProfile{
+				{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "starlingx.windriver.com/v1", Kind: starlingxv1.KindHostProfile},
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-0-profile", Namespace: "deployment"},
+					Spec: starlingxv1.HostProfileSpec{
+						Interfaces: &starlingxv1.InterfaceInfo{
+							Ethernet: []starlingxv1.EthernetInfo{
+								{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{Name: "oam0", MTU: &mtu}},
+								{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{Name: "mgmt0"}},
+							},
+						},
+					},
+				},
+			},
+			Hosts: []*starlingxv1.Host{
+				{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "starlingx.windriver.com/v1", Kind: starlingxv1.KindHost},
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "deployment"},
+					Spec:       starlingxv1.HostSpec{Profile: "controller-0-profile"},
+				},
+			},
+		}
+	}
+
+	Describe("ParseDeployment", func() {
+		Context("when given the output of ToYAML", func() {
+			It("should decode every resource", func() {
+				d := newDeployment()
+				buf, err := d.ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				got, err := ParseDeployment([]byte("# Generated: now\n" + buf))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got.System.Name).To(Equal("vbox"))
+				Expect(got.Profiles).To(HaveLen(1))
+				Expect(got.Hosts).To(HaveLen(1))
+				Expect(got.Hosts[0].Spec.Profile).To(Equal("controller-0-profile"))
+			})
+		})
+
+		Context("when a document has no kind", func() {
+			It("should report the line of the document", func() {
+				input := "---\nkind: Host\nmetadata:\n  name: a\n---\nmetadata:\n  name: b\n"
+				_, err := ParseDeployment([]byte(input))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("line 6"))
+			})
+		})
+	})
+
+	Describe("DiffDeployments", func() {
+		Context("when both deployments are identical", func() {
+			It("should not report any drift", func() {
+				deltas, err := DiffDeployments(newDeployment(), newDeployment())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deltas).To(BeEmpty())
+			})
+		})
+
+		Context("when resources and fields differ", func() {
+			It("should report each difference", func() {
+				expected := newDeployment()
+				observed := newDeployment()
+
+				mtu := 9000
+				observed.Profiles[0].Spec.Interfaces.Ethernet[0].MTU = &mtu
+				// Reordering named list elements is not drift.
+				eth := observed.Profiles[0].Spec.Interfaces.Ethernet
+				eth[0], eth[1] = eth[1], eth[0]
+				observed.Hosts = append(observed.Hosts, &starlingxv1.Host{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-1"},
+				})
+				expected.System.Spec.Description = nil
+
+				deltas, err := DiffDeployments(expected, observed)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deltas).To(Equal([]ResourceDelta{
+					{
+						Kind:      starlingxv1.KindSystem,
+						Name:      "vbox",
+						Operation: DeltaChanged,
+						Fields: []FieldDelta{
+							{Path: "spec.description", Operation: DeltaAdded, Observed: "lab"},
+						},
+					},
+					{
+						Kind:      starlingxv1.KindHostProfile,
+						Name:      "controller-0-profile",
+						Operation: DeltaChanged,
+						Fields: []FieldDelta{
+							{
+								Path:      "spec.interfaces.ethernet[name=oam0].mtu",
+								Operation: DeltaChanged,
+								Expected:  float64(1500),
+								Observed:  float64(9000),
+							},
+						},
+					},
+					{
+						Kind:      starlingxv1.KindHost,
+						Name:      "controller-1",
+						Operation: DeltaAdded,
+					},
+				}))
+
+				output := DeltaToString(deltas)
+				Expect(output).To(ContainSubstring("HostProfile/controller-0-profile: changed\n"))
+				Expect(output).To(ContainSubstring("-\t\t9000\n+\t\t1500\n"))
+				Expect(output).To(ContainSubstring("Host/controller-1: added\n"))
+			})
+		})
+	})
+})
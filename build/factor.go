@@ -0,0 +1,329 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	hostctrl "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/host"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// baseProfileName is the name given to the profile which contains the
+	// attributes shared by all hosts.
+	baseProfileName = "base"
+
+	// unknownPersonality is used to group profiles that do not specify a
+	// personality.
+	unknownPersonality = "unknown"
+)
+
+// isAlwaysMerged determines whether a field of the given kind is always
+// overwritten when a profile is merged into its parent.  Numeric and boolean
+// values cannot be distinguished from an unset value therefore they must be
+// kept in the lowest profile of the hierarchy.
+func isAlwaysMerged(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// factorStructs moves the fields that hold the same value in every one of
+// the provided structs into the common struct and clears them from the
+// originals.  Struct and struct pointer fields which differ are factored
+// recursively so that partially shared sub-structures are also extracted.
+// Slices are never split since merging them changes their element order.
+// All values must be addressable structs of the same type.
+func factorStructs(common reflect.Value, values []reflect.Value) {
+	t := common.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || isAlwaysMerged(field.Type.Kind()) {
+			continue
+		}
+
+		first := values[0].Field(i)
+
+		shared := true
+		for _, v := range values[1:] {
+			if !reflect.DeepEqual(first.Interface(), v.Field(i).Interface()) {
+				shared = false
+				break
+			}
+		}
+
+		if shared {
+			if !first.IsZero() {
+				common.Field(i).Set(first)
+				for _, v := range values {
+					v.Field(i).Set(reflect.Zero(field.Type))
+				}
+			}
+
+			continue
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			fields := make([]reflect.Value, len(values))
+			for j, v := range values {
+				fields[j] = v.Field(i)
+			}
+
+			factorStructs(common.Field(i), fields)
+
+		case field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct:
+			fields := make([]reflect.Value, 0, len(values))
+			for _, v := range values {
+				if v.Field(i).IsNil() {
+					break
+				}
+				fields = append(fields, v.Field(i).Elem())
+			}
+
+			if len(fields) != len(values) {
+				// At least one of the structs does not set this field so
+				// nothing in it can be shared.
+				continue
+			}
+
+			sub := reflect.New(field.Type.Elem())
+			factorStructs(sub.Elem(), fields)
+
+			if !sub.Elem().IsZero() {
+				common.Field(i).Set(sub)
+			}
+
+			for _, v := range values {
+				if v.Field(i).Elem().IsZero() {
+					v.Field(i).Set(reflect.Zero(field.Type))
+				}
+			}
+		}
+	}
+}
+
+// factorProfileSpecs extracts the attributes shared by all of the provided
+// profile specs into a new spec.  The provided specs are updated to contain
+// only their remaining attributes.
+func factorProfileSpecs(specs []*starlingxv1.HostProfileSpec) *starlingxv1.HostProfileSpec {
+	common := &starlingxv1.HostProfileSpec{}
+
+	values := make([]reflect.Value, len(specs))
+	for i, s := range specs {
+		values[i] = reflect.ValueOf(s).Elem()
+	}
+
+	factorStructs(reflect.ValueOf(common).Elem(), values)
+
+	return common
+}
+
+// uniqueProfileName returns a profile name based on the provided name which
+// does not collide with any of the names already in use.
+func uniqueProfileName(name string, used map[string]bool) string {
+	result := fmt.Sprintf("%s-profile", name)
+	for i := 1; used[result]; i++ {
+		result = fmt.Sprintf("%s-profile-%d", name, i)
+	}
+
+	used[result] = true
+
+	return result
+}
+
+// newFactoredProfile creates a new profile which holds attributes shared by
+// other profiles.
+func newFactoredProfile(name string, template *starlingxv1.HostProfile, spec *starlingxv1.HostProfileSpec) *starlingxv1.HostProfile {
+	profile := starlingxv1.HostProfile{
+		TypeMeta: template.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: template.Namespace,
+			Labels: map[string]string{
+				starlingxv1.ControllerToolsLabel: starlingxv1.ControllerToolsVersion,
+			},
+		},
+	}
+
+	spec.DeepCopyInto(&profile.Spec)
+
+	return &profile
+}
+
+// flattenHostProfile merges a profile with all of its base profiles using the
+// same merge rules that are applied by the host controller.
+func flattenHostProfile(name string, profiles map[string]*starlingxv1.HostProfile) (*starlingxv1.HostProfileSpec, error) {
+	chain := make([]*starlingxv1.HostProfileSpec, 0)
+	visited := make(map[string]bool)
+
+	for current := name; current != ""; {
+		if visited[current] {
+			return nil, perrors.Errorf("profile loop detected at: %s", current)
+		}
+		visited[current] = true
+
+		profile, ok := profiles[current]
+		if !ok {
+			return nil, perrors.Errorf("unable to find profile %q", current)
+		}
+
+		chain = append(chain, profile.Spec.DeepCopy())
+
+		current = ""
+		if profile.Spec.Base != nil {
+			current = *profile.Spec.Base
+		}
+	}
+
+	result := chain[len(chain)-1]
+	for i := len(chain) - 2; i >= 0; i-- {
+		var err error
+		result, err = hostctrl.MergeProfiles(result, chain[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Base = nil
+
+	return result, nil
+}
+
+// factorHostProfiles reorganizes the host profiles into a hierarchy to reduce
+// the amount of duplication across them.  Attributes shared by all profiles
+// are moved to a common base profile, attributes shared by all profiles of
+// the same personality are moved to a per-personality profile which uses the
+// common profile as its base, and each host is left with a thin leaf profile
+// containing only its own attributes.  Leaf profiles which end up empty are
+// removed and their hosts refer to the personality profile directly.  The
+// flattened result of each host profile is verified against the original and
+// the profiles are left unchanged if they do not match.
+func (db *DeploymentBuilder) factorHostProfiles(d *Deployment) error {
+	if len(d.Profiles) < 2 {
+		return nil
+	}
+
+	used := make(map[string]bool)
+	originals := make(map[string]*starlingxv1.HostProfileSpec)
+	leaves := make([]*starlingxv1.HostProfile, len(d.Profiles))
+	groups := make(map[string][]*starlingxv1.HostProfile)
+
+	for i, p := range d.Profiles {
+		used[p.Name] = true
+		originals[p.Name] = p.Spec.DeepCopy()
+		leaves[i] = p.DeepCopy()
+
+		personality := unknownPersonality
+		if p.Spec.Personality != nil {
+			personality = *p.Spec.Personality
+		}
+
+		groups[personality] = append(groups[personality], leaves[i])
+	}
+
+	specs := make([]*starlingxv1.HostProfileSpec, len(leaves))
+	for i, p := range leaves {
+		specs[i] = &p.Spec
+	}
+
+	result := make([]*starlingxv1.HostProfile, 0)
+
+	var baseName *string
+	if baseSpec := factorProfileSpecs(specs); !baseSpec.DeepEqual(&starlingxv1.HostProfileSpec{}) {
+		name := uniqueProfileName(baseProfileName, used)
+		baseName = &name
+		result = append(result, newFactoredProfile(name, leaves[0], baseSpec))
+		db.progressUpdate("...Moving common attributes to %q\n", name)
+	}
+
+	personalities := make([]string, 0, len(groups))
+	for personality := range groups {
+		personalities = append(personalities, personality)
+	}
+	sort.Strings(personalities)
+
+	for _, personality := range personalities {
+		group := groups[personality]
+		parent := baseName
+
+		if len(group) > 1 {
+			groupSpecs := make([]*starlingxv1.HostProfileSpec, len(group))
+			for i, p := range group {
+				groupSpecs[i] = &p.Spec
+			}
+
+			spec := factorProfileSpecs(groupSpecs)
+			if !spec.DeepEqual(&starlingxv1.HostProfileSpec{}) {
+				name := uniqueProfileName(personality, used)
+				spec.Base = baseName
+				result = append(result, newFactoredProfile(name, group[0], spec))
+				parent = &name
+				db.progressUpdate("...Moving %s attributes to %q\n", personality, name)
+			}
+		}
+
+		for _, p := range group {
+			p.Spec.Base = parent
+		}
+	}
+
+	replacements := make(map[string]string)
+	for _, p := range leaves {
+		empty := starlingxv1.HostProfileSpec{Base: p.Spec.Base}
+		if p.Spec.Base != nil && p.Spec.DeepEqual(&empty) {
+			replacements[p.Name] = *p.Spec.Base
+			db.progressUpdate("...Profile %q is empty using %q instead\n", p.Name, *p.Spec.Base)
+			continue
+		}
+
+		result = append(result, p)
+	}
+
+	index := make(map[string]*starlingxv1.HostProfile, len(result))
+	for _, p := range result {
+		index[p.Name] = p
+	}
+
+	// Verify that each host still resolves to exactly the same profile.
+	for _, host := range d.Hosts {
+		name := host.Spec.Profile
+		if replacement, ok := replacements[name]; ok {
+			name = replacement
+		}
+
+		flattened, err := flattenHostProfile(name, index)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to flatten profile for host %q", host.Name)
+			return err
+		}
+
+		if !flattened.DeepEqual(originals[host.Spec.Profile]) {
+			db.progressUpdate("...Profile %q cannot be factored; keeping profiles unchanged\n", host.Spec.Profile)
+			return nil
+		}
+	}
+
+	for _, host := range d.Hosts {
+		if replacement, ok := replacements[host.Spec.Profile]; ok {
+			host.Spec.Profile = replacement
+		}
+	}
+
+	d.Profiles = result
+
+	return nil
+}
@@ -0,0 +1,135 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Factor utilities", func() {
+	Describe("FactorHostProfiles", func() {
+		newProfile := func(name, personality, console string, mtu int) *starlingxv1.HostProfile {
+			state := "unlocked"
+			return &starlingxv1.HostProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fakens"},
+				Spec: starlingxv1.HostProfileSpec{
+					ProfileBaseAttributes: starlingxv1.ProfileBaseAttributes{
+						Personality:         &personality,
+						AdministrativeState: &state,
+						Console:             &console,
+					},
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{
+							{CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{Name: "mgmt0", MTU: &mtu}},
+						},
+					},
+				},
+			}
+		}
+
+		newHost := func(name, profile string) *starlingxv1.Host {
+			return &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fakens"},
+				Spec:       starlingxv1.HostSpec{Profile: profile},
+			}
+		}
+
+		Context("when profiles share attributes", func() {
+			It("should build a hierarchy that flattens to the original profiles", func() {
+				d := Deployment{
+					Profiles: []*starlingxv1.HostProfile{
+						newProfile("controller-0-profile", "controller", "ttyS0", 1500),
+						newProfile("worker-0-profile", "worker", "ttyS0", 9000),
+						newProfile("worker-1-profile", "worker", "ttyS1", 9000),
+					},
+					Hosts: []*starlingxv1.Host{
+						newHost("controller-0", "controller-0-profile"),
+						newHost("worker-0", "worker-0-profile"),
+						newHost("worker-1", "worker-1-profile"),
+					},
+				}
+
+				originals := make(map[string]*starlingxv1.HostProfileSpec)
+				for _, h := range d.Hosts {
+					for _, p := range d.Profiles {
+						if p.Name == h.Spec.Profile {
+							originals[h.Name] = p.Spec.DeepCopy()
+						}
+					}
+				}
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				Expect(db.factorHostProfiles(&d)).To(Succeed())
+
+				names := make([]string, 0)
+				index := make(map[string]*starlingxv1.HostProfile)
+				for _, p := range d.Profiles {
+					names = append(names, p.Name)
+					index[p.Name] = p
+				}
+
+				Expect(names).To(Equal([]string{
+					"base-profile", "worker-profile",
+					"controller-0-profile", "worker-0-profile", "worker-1-profile",
+				}))
+
+				base := index["base-profile"]
+				Expect(base.Spec.Base).To(BeNil())
+				Expect(*base.Spec.AdministrativeState).To(Equal("unlocked"))
+				Expect(base.Spec.Interfaces).To(BeNil())
+
+				worker := index["worker-profile"]
+				Expect(*worker.Spec.Base).To(Equal("base-profile"))
+				Expect(*worker.Spec.Personality).To(Equal("worker"))
+				Expect(worker.Spec.Interfaces).ToNot(BeNil())
+
+				leaf := index["worker-0-profile"]
+				Expect(*leaf.Spec.Base).To(Equal("worker-profile"))
+				Expect(*leaf.Spec.Console).To(Equal("ttyS0"))
+				Expect(leaf.Spec.Personality).To(BeNil())
+				Expect(leaf.Spec.Interfaces).To(BeNil())
+
+				for _, h := range d.Hosts {
+					flattened, err := flattenHostProfile(h.Spec.Profile, index)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(flattened.DeepEqual(originals[h.Name])).To(BeTrue())
+				}
+			})
+		})
+
+		Context("when a leaf profile is left without attributes", func() {
+			It("should point the host at its parent profile", func() {
+				d := Deployment{
+					Profiles: []*starlingxv1.HostProfile{
+						newProfile("controller-0-profile", "controller", "ttyS0", 1500),
+						newProfile("worker-0-profile", "worker", "ttyS0", 9000),
+						newProfile("worker-1-profile", "worker", "ttyS0", 9000),
+					},
+					Hosts: []*starlingxv1.Host{
+						newHost("controller-0", "controller-0-profile"),
+						newHost("worker-0", "worker-0-profile"),
+						newHost("worker-1", "worker-1-profile"),
+					},
+				}
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				Expect(db.factorHostProfiles(&d)).To(Succeed())
+
+				Expect(d.Profiles).To(HaveLen(3))
+				Expect(d.Profiles[0].Name).To(Equal("base-profile"))
+				Expect(d.Profiles[1].Name).To(Equal("worker-profile"))
+				Expect(d.Profiles[2].Name).To(Equal("controller-0-profile"))
+				Expect(*d.Profiles[2].Spec.Base).To(Equal("base-profile"))
+				Expect(d.Hosts[0].Spec.Profile).To(Equal("controller-0-profile"))
+				Expect(d.Hosts[1].Spec.Profile).To(Equal("worker-profile"))
+				Expect(d.Hosts[2].Spec.Profile).To(Equal("worker-profile"))
+			})
+		})
+	})
+})
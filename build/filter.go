@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package build
 
@@ -26,18 +26,46 @@ type HostFilter interface {
 	Filter(profile *v1.HostProfile, host *v1.Host, deployment *Deployment) error
 }
 
+// ReportingFilter is implemented by filters which keep a description of
+// their actions that is more concise than the attribute level changes
+// recorded for every filter in a build report.
+type ReportingFilter interface {
+	Notes() []string
+}
+
+// HostExcludingFilter is implemented by filters which exclude hosts from
+// being statically provisioned.
+type HostExcludingFilter interface {
+	ExcludedHosts() []string
+	ExclusionReason() string
+}
+
 // Controller0Filter defines a host filter which is responsible for changing
 // the provisioning mode of the controller-0 nodes from static to dynamic since
 // we never statically provisioning controller-0 as it is always pre-populated.
 type Controller0Filter struct {
+	excluded []string
 }
 
 func NewController0Filter() *Controller0Filter {
 	return &Controller0Filter{}
 }
 
+// ExcludedHosts returns the hosts which were left to be provisioned
+// dynamically rather than being statically provisioned.
+func (in *Controller0Filter) ExcludedHosts() []string {
+	return in.excluded
+}
+
+// ExclusionReason describes why controller-0 is not statically provisioned.
+func (in *Controller0Filter) ExclusionReason() string {
+	return Controller0ExclusionReason
+}
+
 func (in *Controller0Filter) Filter(profile *v1.HostProfile, host *v1.Host, deployment *Deployment) error {
 	if host.Name == hosts.Controller0 {
+		in.excluded = append(in.excluded, host.Name)
+
 		// Controller0 must always be dynamic since it is expected to
 		// already be present.  Set this in the overrides rather than in the
 		// profile to minimize the number of profiles required.
@@ -437,6 +465,8 @@ func (in *VolumeGroupFilter) Filter(profile *v1.HostProfile, deployment *Deploym
 // the number of profiles that exist in the system definition.
 type InterfaceNamingFilter struct {
 	updates map[string]string
+	renamed [][2]string
+	notes   []string
 }
 
 func NewInterfaceNamingFilter() *InterfaceNamingFilter {
@@ -467,27 +497,38 @@ func (in *InterfaceNamingFilter) CheckInterface(info *v1.CommonInterfaceInfo) {
 
 	if utils.ContainsString(networks, pxebootNetwork) {
 		if info.Name != pxebootIface {
-			in.updates[info.Name] = pxebootIface
-			info.Name = pxebootIface
+			in.rename(info, pxebootIface)
 		}
 	} else if utils.ContainsString(networks, mgmtNetwork) {
 		if info.Name != mgmtIface {
-			in.updates[info.Name] = mgmtIface
-			info.Name = mgmtIface
+			in.rename(info, mgmtIface)
 		}
 	} else if utils.ContainsString(networks, clusterNetwork) {
 		if info.Name != clusterNetwork {
-			in.updates[info.Name] = clusterIface
-			info.Name = clusterIface
+			in.rename(info, clusterIface)
 		}
 	} else if utils.ContainsString(networks, oamNetwork) {
 		if info.Name != oamNetwork {
-			in.updates[info.Name] = oamIface
-			info.Name = oamIface
+			in.rename(info, oamIface)
 		}
 	}
 }
 
+// rename changes the name of an interface and records the change so that
+// references to the old name can be updated.
+func (in *InterfaceNamingFilter) rename(info *v1.CommonInterfaceInfo, name string) {
+	in.updates[info.Name] = name
+	if info.Name != name {
+		in.renamed = append(in.renamed, [2]string{info.Name, name})
+	}
+	info.Name = name
+}
+
+// Notes returns a description of each interface renamed by the filter.
+func (in *InterfaceNamingFilter) Notes() []string {
+	return in.notes
+}
+
 func (in *InterfaceNamingFilter) Reset() {
 	in.updates = make(map[string]string)
 }
@@ -541,6 +582,12 @@ func (in *InterfaceNamingFilter) Filter(profile *v1.HostProfile, deployment *Dep
 		}
 	}
 
+	for _, r := range in.renamed {
+		in.notes = append(in.notes,
+			fmt.Sprintf("%s: renamed interface %q to %q", profile.Name, r[0], r[1]))
+	}
+	in.renamed = nil
+
 	return nil
 }
 
@@ -983,3 +1030,196 @@ func (in *HostKernelFilter) Filter(profile *v1.HostProfile, host *v1.Host, deplo
 
 	return nil
 }
+
+// FilterParameters defines the named parameters which can be supplied to a
+// filter when it is selected by name from the filter registry.
+type FilterParameters map[string]interface{}
+
+// checkKeys ensures that only the supported parameter names were supplied.
+func (p FilterParameters) checkKeys(supported ...string) error {
+	for key := range p {
+		if !utils.ContainsString(supported, key) {
+			return fmt.Errorf("unsupported filter parameter %q", key)
+		}
+	}
+
+	return nil
+}
+
+// StringList returns the value of a parameter which holds a list of strings,
+// or the provided default value if the parameter was not supplied.
+func (p FilterParameters) StringList(key string, defaultValue []string) ([]string, error) {
+	value, ok := p[key]
+	if !ok {
+		return defaultValue, nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("filter parameter %q must be a list of strings", key)
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("filter parameter %q must be a list of strings", key)
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// SystemFilterFactory defines the function used to instantiate a system
+// filter from its parameters.
+type SystemFilterFactory func(params FilterParameters) (SystemFilter, error)
+
+// ProfileFilterFactory defines the function used to instantiate a profile
+// filter from its parameters.
+type ProfileFilterFactory func(params FilterParameters) (ProfileFilter, error)
+
+// HostFilterFactory defines the function used to instantiate a host filter
+// from its parameters.
+type HostFilterFactory func(params FilterParameters) (HostFilter, error)
+
+// PlatformNetworkFilterFactory defines the function used to instantiate a
+// platform network filter from its parameters.
+type PlatformNetworkFilterFactory func(params FilterParameters) (PlatformNetworkFilter, error)
+
+// Filter registry names.
+const (
+	DRBDLinkUtilizationFilterName = "drbd-link-utilization"
+	FileSystemFilterName          = "file-systems"
+	CACertificateFilterName       = "ca-certificates"
+	ServiceParametersFilterName   = "default-service-parameters"
+	NoServiceParametersFilterName = "service-parameters"
+	InterfaceUnusedFilterName     = "unused-interfaces"
+	MemoryClearAllFilterName      = "memory"
+	MemoryDefaultsFilterName      = "memory-defaults"
+	ProcessorClearAllFilterName   = "processors"
+	ProcessorDefaultsFilterName   = "processor-defaults"
+	VolumeGroupFilterName         = "volume-groups"
+	InterfaceNamingFilterName     = "interface-names"
+	InterfaceMTUFilterName        = "interface-mtu"
+	ConsoleNameFilterName         = "console"
+	InterfaceDefaultsFilterName   = "interface-defaults"
+	Controller0FilterName         = "controller-0"
+	LocationFilterName            = "location"
+	AddressFilterName             = "addresses"
+	BMAddressFilterName           = "bm-address"
+	StorageMonitorFilterName      = "storage-monitors"
+	LoopbackInterfaceFilterName   = "loopback-interfaces"
+	InterfaceRemoveUuidFilterName = "interface-uuids"
+	HostKernelFilterName          = "kernel"
+	CoreNetworkFilterName         = "core-networks"
+	AddressPoolFilterName         = "address-pools"
+)
+
+// volumeGroupBlacklistParameterName is the name of the parameter which lists
+// the volume groups to be removed by the volume group filter.
+const volumeGroupBlacklistParameterName = "blacklist"
+
+// SystemFilterRegistry lists the system filters which can be selected by
+// name.
+var SystemFilterRegistry = map[string]SystemFilterFactory{
+	DRBDLinkUtilizationFilterName: func(params FilterParameters) (SystemFilter, error) {
+		return NewDRBDLinkUtilizationFilter(), params.checkKeys()
+	},
+	FileSystemFilterName: func(params FilterParameters) (SystemFilter, error) {
+		return NewFileSystemFilter(), params.checkKeys()
+	},
+	CACertificateFilterName: func(params FilterParameters) (SystemFilter, error) {
+		return NewCACertificateFilter(), params.checkKeys()
+	},
+	ServiceParametersFilterName: func(params FilterParameters) (SystemFilter, error) {
+		return NewServiceParametersSystemFilter(), params.checkKeys()
+	},
+	NoServiceParametersFilterName: func(params FilterParameters) (SystemFilter, error) {
+		return NewNoServiceParametersSystemFilter(), params.checkKeys()
+	},
+}
+
+// ProfileFilterRegistry lists the profile filters which can be selected by
+// name.
+var ProfileFilterRegistry = map[string]ProfileFilterFactory{
+	InterfaceUnusedFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewInterfaceUnusedFilter(), params.checkKeys()
+	},
+	MemoryClearAllFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewMemoryClearAllFilter(), params.checkKeys()
+	},
+	MemoryDefaultsFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewMemoryDefaultsFilter(), params.checkKeys()
+	},
+	ProcessorClearAllFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewProcessorClearAllFilter(), params.checkKeys()
+	},
+	ProcessorDefaultsFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewProcessorDefaultsFilter(), params.checkKeys()
+	},
+	VolumeGroupFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		if err := params.checkKeys(volumeGroupBlacklistParameterName); err != nil {
+			return nil, err
+		}
+
+		blacklist, err := params.StringList(volumeGroupBlacklistParameterName,
+			volumegroups.SystemDefinedVolumeGroups)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVolumeGroupFilter(blacklist), nil
+	},
+	InterfaceNamingFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewInterfaceNamingFilter(), params.checkKeys()
+	},
+	InterfaceMTUFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewInterfaceMTUFilter(), params.checkKeys()
+	},
+	ConsoleNameFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewConsoleNameFilter(), params.checkKeys()
+	},
+	InterfaceDefaultsFilterName: func(params FilterParameters) (ProfileFilter, error) {
+		return NewInterfaceDefaultsFilter(), params.checkKeys()
+	},
+}
+
+// HostFilterRegistry lists the host filters which can be selected by name.
+var HostFilterRegistry = map[string]HostFilterFactory{
+	Controller0FilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewController0Filter(), params.checkKeys()
+	},
+	LocationFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewLocationFilter(), params.checkKeys()
+	},
+	AddressFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewAddressFilter(), params.checkKeys()
+	},
+	BMAddressFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewBMAddressFilter(), params.checkKeys()
+	},
+	StorageMonitorFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewStorageMonitorFilter(), params.checkKeys()
+	},
+	LoopbackInterfaceFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewLoopbackInterfaceFilter(), params.checkKeys()
+	},
+	InterfaceRemoveUuidFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewInterfaceRemoveUuidFilter(), params.checkKeys()
+	},
+	HostKernelFilterName: func(params FilterParameters) (HostFilter, error) {
+		return NewHostKernelFilter(), params.checkKeys()
+	},
+}
+
+// PlatformNetworkFilterRegistry lists the platform network filters which can
+// be selected by name.
+var PlatformNetworkFilterRegistry = map[string]PlatformNetworkFilterFactory{
+	CoreNetworkFilterName: func(params FilterParameters) (PlatformNetworkFilter, error) {
+		return NewCoreNetworkFilter(), params.checkKeys()
+	},
+	AddressPoolFilterName: func(params FilterParameters) (PlatformNetworkFilter, error) {
+		return NewAddressPoolFilter(), params.checkKeys()
+	},
+}
@@ -0,0 +1,329 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+)
+
+// Filter preset names.  Each preset corresponds to one of the filter
+// shorthand options accepted by the deployctl tool.
+const (
+	NoDefaultsPreset              = "no-defaults"
+	NoCACertificatesPreset        = "no-ca-certificates"
+	NoMemoryPreset                = "no-memory"
+	NoProcessorsPreset            = "no-processors"
+	NoInterfaceDefaultsPreset     = "no-interface-defaults"
+	NoServiceParametersPreset     = "no-service-parameters"
+	NoSysVgPreset                 = "no-sys-vg"
+	NormalizeInterfaceNamesPreset = "normalize-interfaces"
+	NormalizeInterfaceMTUPreset   = "normalize-mtu"
+	NormalizeConsolePreset        = "normalize-console"
+	MinimalConfigPreset           = "minimal-config"
+)
+
+// FilterEntry selects a single filter from the filter registry.
+type FilterEntry struct {
+	// Name is the name under which the filter is registered.
+	Name string `json:"name"`
+
+	// Parameters are the optional settings supported by the filter.
+	Parameters FilterParameters `json:"parameters,omitempty"`
+}
+
+// FilterConfig defines the set of filters to be added to the deployment
+// builder in addition to its default filters.  The filters enabled by the
+// presets are added first, in a fixed order, followed by the filters listed
+// for each filter type in the order in which they are listed.
+type FilterConfig struct {
+	// Presets is the list of filter presets to be expanded.
+	Presets []string `json:"presets,omitempty"`
+
+	// System is the list of system filters.
+	System []FilterEntry `json:"system,omitempty"`
+
+	// Profile is the list of host profile filters.
+	Profile []FilterEntry `json:"profile,omitempty"`
+
+	// Host is the list of host filters.
+	Host []FilterEntry `json:"host,omitempty"`
+
+	// PlatformNetwork is the list of platform network filters.
+	PlatformNetwork []FilterEntry `json:"platformNetwork,omitempty"`
+}
+
+// ParseFilterConfig decodes a filter configuration from its YAML
+// representation.  Unknown attributes are rejected so that misspelled
+// attributes are not silently ignored.
+func ParseFilterConfig(data []byte) (*FilterConfig, error) {
+	buf, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse filter configuration")
+		return nil, err
+	}
+
+	config := FilterConfig{}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&config)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to decode filter configuration")
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// presetOptions records which individual filters are enabled by a set of
+// presets.
+type presetOptions struct {
+	normalizeInterfaces    bool
+	noInterfaceDefaults    bool
+	normalizeConsole       bool
+	noCACertificates       bool
+	noDRBDLinkUtilization  bool
+	noCorePlatformNetworks bool
+	noFileSystems          bool
+	noServiceParams        bool
+	noProcessors           bool
+	normalizeMTU           bool
+	noDefaults             bool
+	noMemory               bool
+	noSysVg                bool
+}
+
+// expandPresets converts the preset names into the list of filter entries
+// which they enable.  The filters are ordered the same way regardless of the
+// order in which the presets were specified.
+func expandPresets(presets []string) (*FilterConfig, error) {
+	var opts presetOptions
+
+	for _, preset := range presets {
+		switch preset {
+		case NoDefaultsPreset:
+			opts.noDefaults = true
+		case NoCACertificatesPreset:
+			opts.noCACertificates = true
+		case NoMemoryPreset:
+			opts.noMemory = true
+		case NoProcessorsPreset:
+			opts.noProcessors = true
+		case NoInterfaceDefaultsPreset:
+			opts.noInterfaceDefaults = true
+		case NoServiceParametersPreset:
+			opts.noServiceParams = true
+		case NoSysVgPreset:
+			opts.noSysVg = true
+		case NormalizeInterfaceNamesPreset:
+			opts.normalizeInterfaces = true
+		case NormalizeInterfaceMTUPreset:
+			opts.normalizeMTU = true
+		case NormalizeConsolePreset:
+			opts.normalizeConsole = true
+		case MinimalConfigPreset:
+			opts.noCACertificates = true
+			opts.noDefaults = true
+			opts.noDRBDLinkUtilization = true
+			opts.noCorePlatformNetworks = true
+			opts.noFileSystems = true
+			opts.noInterfaceDefaults = true
+			opts.normalizeInterfaces = true
+			opts.normalizeMTU = true
+			opts.normalizeConsole = true
+			opts.noServiceParams = true
+		default:
+			return nil, perrors.Errorf("unknown filter preset %q", preset)
+		}
+	}
+
+	if opts.noDefaults {
+		opts.noDRBDLinkUtilization = true
+		opts.noFileSystems = true
+		opts.noCACertificates = true
+	}
+
+	config := FilterConfig{}
+
+	profile := func(name string) {
+		config.Profile = append(config.Profile, FilterEntry{Name: name})
+	}
+
+	if opts.noDefaults {
+		profile(InterfaceUnusedFilterName)
+	}
+
+	if opts.noDefaults && !opts.noMemory {
+		profile(MemoryDefaultsFilterName)
+	} else if opts.noMemory {
+		profile(MemoryClearAllFilterName)
+	}
+
+	if opts.noDefaults && !opts.noProcessors {
+		profile(ProcessorDefaultsFilterName)
+	} else if opts.noProcessors {
+		profile(ProcessorClearAllFilterName)
+	}
+
+	if opts.noInterfaceDefaults {
+		profile(InterfaceDefaultsFilterName)
+	}
+
+	if opts.normalizeInterfaces {
+		profile(InterfaceNamingFilterName)
+	}
+
+	if opts.normalizeMTU {
+		profile(InterfaceMTUFilterName)
+	}
+
+	if opts.normalizeConsole {
+		profile(ConsoleNameFilterName)
+	}
+
+	if opts.noSysVg {
+		profile(VolumeGroupFilterName)
+	}
+
+	system := func(name string) {
+		config.System = append(config.System, FilterEntry{Name: name})
+	}
+
+	if opts.noDRBDLinkUtilization {
+		system(DRBDLinkUtilizationFilterName)
+	}
+
+	if opts.noFileSystems {
+		system(FileSystemFilterName)
+	}
+
+	if opts.noCACertificates {
+		system(CACertificateFilterName)
+	}
+
+	if opts.noServiceParams {
+		system(NoServiceParametersFilterName)
+	}
+
+	if opts.noCorePlatformNetworks {
+		config.PlatformNetwork = append(config.PlatformNetwork,
+			FilterEntry{Name: CoreNetworkFilterName})
+	}
+
+	return &config, nil
+}
+
+// Expand returns the complete list of filter entries selected by the
+// configuration with all presets replaced by the filters that they enable.
+func (c *FilterConfig) Expand() (*FilterConfig, error) {
+	result, err := expandPresets(c.Presets)
+	if err != nil {
+		return nil, err
+	}
+
+	result.System = append(result.System, c.System...)
+	result.Profile = append(result.Profile, c.Profile...)
+	result.Host = append(result.Host, c.Host...)
+	result.PlatformNetwork = append(result.PlatformNetwork, c.PlatformNetwork...)
+
+	return result, nil
+}
+
+// filterError formats an error raised while instantiating a filter.
+func filterError(filterType string, name string, err error) error {
+	return perrors.Wrapf(err, "invalid %s filter %q", filterType, name)
+}
+
+// Apply instantiates every filter selected by the configuration and adds them
+// to the deployment builder.
+func (c *FilterConfig) Apply(builder Builder) error {
+	config, err := c.Expand()
+	if err != nil {
+		return err
+	}
+
+	systemFilters := make([]SystemFilter, 0)
+	for _, entry := range config.System {
+		factory, ok := SystemFilterRegistry[entry.Name]
+		if !ok {
+			return filterError("system", entry.Name, fmt.Errorf("not found"))
+		}
+
+		filter, err := factory(entry.Parameters)
+		if err != nil {
+			return filterError("system", entry.Name, err)
+		}
+
+		systemFilters = append(systemFilters, filter)
+	}
+
+	profileFilters := make([]ProfileFilter, 0)
+	for _, entry := range config.Profile {
+		factory, ok := ProfileFilterRegistry[entry.Name]
+		if !ok {
+			return filterError("profile", entry.Name, fmt.Errorf("not found"))
+		}
+
+		filter, err := factory(entry.Parameters)
+		if err != nil {
+			return filterError("profile", entry.Name, err)
+		}
+
+		profileFilters = append(profileFilters, filter)
+	}
+
+	hostFilters := make([]HostFilter, 0)
+	for _, entry := range config.Host {
+		factory, ok := HostFilterRegistry[entry.Name]
+		if !ok {
+			return filterError("host", entry.Name, fmt.Errorf("not found"))
+		}
+
+		filter, err := factory(entry.Parameters)
+		if err != nil {
+			return filterError("host", entry.Name, err)
+		}
+
+		hostFilters = append(hostFilters, filter)
+	}
+
+	platformNetworkFilters := make([]PlatformNetworkFilter, 0)
+	for _, entry := range config.PlatformNetwork {
+		factory, ok := PlatformNetworkFilterRegistry[entry.Name]
+		if !ok {
+			return filterError("platform network", entry.Name, fmt.Errorf("not found"))
+		}
+
+		filter, err := factory(entry.Parameters)
+		if err != nil {
+			return filterError("platform network", entry.Name, err)
+		}
+
+		platformNetworkFilters = append(platformNetworkFilters, filter)
+	}
+
+	if len(systemFilters) > 0 {
+		builder.AddSystemFilters(systemFilters)
+	}
+
+	if len(profileFilters) > 0 {
+		builder.AddProfileFilters(profileFilters)
+	}
+
+	if len(hostFilters) > 0 {
+		builder.AddHostFilters(hostFilters)
+	}
+
+	if len(platformNetworkFilters) > 0 {
+		builder.AddPlatformNetworkFilters(platformNetworkFilters)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"io"
+
+	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/volumegroups"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filter configuration utilities", func() {
+	names := func(entries []FilterEntry) []string {
+		result := make([]string, 0, len(entries))
+		for _, e := range entries {
+			result = append(result, e.Name)
+		}
+		return result
+	}
+
+	Describe("FilterConfig presets", func() {
+		Context("when the minimal-config preset is selected", func() {
+			It("should expand to the filters enabled by the shorthand option", func() {
+				config := FilterConfig{Presets: []string{MinimalConfigPreset}}
+				got, err := config.Expand()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(names(got.Profile)).To(Equal([]string{
+					InterfaceUnusedFilterName,
+					MemoryDefaultsFilterName,
+					ProcessorDefaultsFilterName,
+					InterfaceDefaultsFilterName,
+					InterfaceNamingFilterName,
+					InterfaceMTUFilterName,
+					ConsoleNameFilterName,
+				}))
+				Expect(names(got.System)).To(Equal([]string{
+					DRBDLinkUtilizationFilterName,
+					FileSystemFilterName,
+					CACertificateFilterName,
+					NoServiceParametersFilterName,
+				}))
+				Expect(names(got.PlatformNetwork)).To(Equal([]string{CoreNetworkFilterName}))
+				Expect(got.Host).To(BeEmpty())
+			})
+		})
+
+		Context("when no-memory is combined with no-defaults", func() {
+			It("should clear all memory regardless of preset order", func() {
+				a, err := (&FilterConfig{Presets: []string{NoMemoryPreset, NoDefaultsPreset}}).Expand()
+				Expect(err).ToNot(HaveOccurred())
+				b, err := (&FilterConfig{Presets: []string{NoDefaultsPreset, NoMemoryPreset}}).Expand()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(a).To(Equal(b))
+				Expect(names(a.Profile)).To(ContainElement(MemoryClearAllFilterName))
+				Expect(names(a.Profile)).ToNot(ContainElement(MemoryDefaultsFilterName))
+			})
+		})
+
+		Context("when an unknown preset is selected", func() {
+			It("should fail", func() {
+				_, err := (&FilterConfig{Presets: []string{"no-everything"}}).Expand()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ParseFilterConfig", func() {
+		Context("when parameterised filters are listed", func() {
+			It("should instantiate them with their parameters", func() {
+				data := []byte(`
+presets:
+  - normalize-console
+profile:
+  - name: volume-groups
+    parameters:
+      blacklist:
+        - cgts-vg
+        - nova-local
+host:
+  - name: kernel
+`)
+				config, err := ParseFilterConfig(data)
+				Expect(err).ToNot(HaveOccurred())
+
+				db := NewDeploymentBuilder(nil, "fakens", "fake", io.Discard)
+				hosts := len(db.hostFilters)
+				Expect(config.Apply(db)).To(Succeed())
+
+				Expect(db.profileFilters).To(HaveLen(2))
+				Expect(db.profileFilters[0]).To(BeAssignableToTypeOf(&ConsoleNameFilter{}))
+				Expect(db.profileFilters[1]).To(Equal(&VolumeGroupFilter{
+					Blacklist: []string{"cgts-vg", "nova-local"},
+				}))
+				Expect(db.hostFilters).To(HaveLen(hosts + 1))
+			})
+
+			It("should use the default parameters when none are supplied", func() {
+				config, err := ParseFilterConfig([]byte("profile:\n  - name: volume-groups\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				db := NewDeploymentBuilder(nil, "fakens", "fake", io.Discard)
+				Expect(config.Apply(db)).To(Succeed())
+				Expect(db.profileFilters).To(Equal([]ProfileFilter{
+					NewVolumeGroupFilter(volumegroups.SystemDefinedVolumeGroups),
+				}))
+			})
+		})
+
+		Context("when the configuration is invalid", func() {
+			It("should reject unknown attributes", func() {
+				_, err := ParseFilterConfig([]byte("profiles:\n  - name: memory\n"))
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should reject unknown filters", func() {
+				config, err := ParseFilterConfig([]byte("system:\n  - name: memory\n"))
+				Expect(err).ToNot(HaveOccurred())
+				db := NewDeploymentBuilder(nil, "fakens", "fake", io.Discard)
+				Expect(config.Apply(db)).ToNot(Succeed())
+			})
+
+			It("should reject unknown parameters", func() {
+				data := []byte("profile:\n  - name: memory\n    parameters:\n      all: true\n")
+				config, err := ParseFilterConfig(data)
+				Expect(err).ToNot(HaveOccurred())
+				db := NewDeploymentBuilder(nil, "fakens", "fake", io.Discard)
+				Expect(config.Apply(db)).ToNot(Succeed())
+			})
+
+			It("should reject malformed parameters", func() {
+				data := []byte("profile:\n  - name: volume-groups\n    parameters:\n      blacklist: cgts-vg\n")
+				config, err := ParseFilterConfig(data)
+				Expect(err).ToNot(HaveOccurred())
+				db := NewDeploymentBuilder(nil, "fakens", "fake", io.Discard)
+				Expect(config.Apply(db)).ToNot(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,319 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// KustomizationFileName is the name of the file which kustomize expects
+	// to find in each directory.
+	KustomizationFileName = "kustomization.yaml"
+
+	// kustomizeBaseDir is the directory which contains the resources shared
+	// by all hosts.
+	kustomizeBaseDir = "base"
+
+	// kustomizeOverlayDir is the directory which contains the per-host
+	// resources layered on top of the base resources.
+	kustomizeOverlayDir = "overlays/hosts"
+
+	// kustomizeSecretsDir is the directory, relative to the base directory,
+	// which contains the secret data files consumed by the secret generator.
+	kustomizeSecretsDir = "secrets"
+)
+
+// KustomizeFile defines a single file of a kustomize directory tree.
+type KustomizeFile struct {
+	// Path is the location of the file relative to the root of the tree.
+	Path string
+
+	// Data is the content of the file.
+	Data []byte
+}
+
+// kustomizeGeneratorOptions defines the subset of the kustomize generator
+// options used by the generated kustomization files.
+type kustomizeGeneratorOptions struct {
+	Labels                map[string]string `json:"labels,omitempty"`
+	Annotations           map[string]string `json:"annotations,omitempty"`
+	DisableNameSuffixHash bool              `json:"disableNameSuffixHash,omitempty"`
+}
+
+// kustomizeSecretGenerator defines the subset of the kustomize secret
+// generator attributes used by the generated kustomization files.
+type kustomizeSecretGenerator struct {
+	Name      string                     `json:"name"`
+	Namespace string                     `json:"namespace,omitempty"`
+	Type      string                     `json:"type,omitempty"`
+	Files     []string                   `json:"files,omitempty"`
+	Options   *kustomizeGeneratorOptions `json:"options,omitempty"`
+}
+
+// kustomization defines the subset of the kustomize kustomization file
+// attributes used by the generated kustomization files.
+type kustomization struct {
+	APIVersion       string                     `json:"apiVersion"`
+	Kind             string                     `json:"kind"`
+	Resources        []string                   `json:"resources,omitempty"`
+	SecretGenerator  []kustomizeSecretGenerator `json:"secretGenerator,omitempty"`
+	GeneratorOptions *kustomizeGeneratorOptions `json:"generatorOptions,omitempty"`
+}
+
+// newKustomization returns a kustomization with its type attributes set.
+func newKustomization() *kustomization {
+	return &kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+	}
+}
+
+// kustomizeTree accumulates the files of a kustomize directory tree.
+type kustomizeTree struct {
+	header string
+	files  []KustomizeFile
+}
+
+// addFile adds a file to the tree.
+func (t *kustomizeTree) addFile(filename string, data []byte) {
+	t.files = append(t.files, KustomizeFile{Path: filename, Data: data})
+}
+
+// addYAML renders an object as YAML and adds it to the tree.  The same
+// clean-up applied to the single document output is applied to each file.
+func (t *kustomizeTree) addYAML(filename string, obj interface{}) error {
+	buf, err := yaml.Marshal(obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to render %s to YAML", filename)
+		return err
+	}
+
+	content := removeCreationTimestamp(removeStatusFields(string(buf)))
+
+	t.addFile(filename, []byte(t.header+content))
+
+	return nil
+}
+
+// resourceFileName returns the path of the file used to store a resource of
+// the given kind, relative to the directory of its kustomization file.
+func resourceFileName(kind string, name string) string {
+	return path.Join(strings.ToLower(kind)+"s", name+".yaml")
+}
+
+// secretGenerator creates the secret generator attributes for a secret and
+// adds one file per data key to the tree.
+func (t *kustomizeTree) secretGenerator(meta v1.Secret, data map[string][]byte) kustomizeSecretGenerator {
+	generator := kustomizeSecretGenerator{
+		Name:      meta.Name,
+		Namespace: meta.Namespace,
+		Type:      string(meta.Type),
+		Files:     make([]string, 0, len(data)),
+	}
+
+	if len(meta.Labels) > 0 || len(meta.Annotations) > 0 {
+		generator.Options = &kustomizeGeneratorOptions{
+			Labels:      meta.Labels,
+			Annotations: meta.Annotations,
+		}
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		filename := path.Join(kustomizeSecretsDir, meta.Name, key)
+		generator.Files = append(generator.Files, fmt.Sprintf("%s=%s", key, filename))
+		t.addFile(path.Join(kustomizeBaseDir, filename), data[key])
+	}
+
+	return generator
+}
+
+// ToKustomize is a utility method to publish the system deployment instance
+// as a kustomize directory tree.  Each resource is stored in its own file,
+// grouped into a directory per kind, and listed in a generated kustomization
+// file.  Secrets are produced by a secret generator from one file per data
+// key so that their content can be edited or substituted without having to
+// encode it.  The base directory holds all resources except for the hosts,
+// which are stored in an overlay directory layered on top of the base.  The
+// kustomization file at the root of the tree refers to the overlay so that
+// the tree can be applied as a whole.  The header, if not empty, is inserted
+// at the top of every YAML file.
+func (d *Deployment) ToKustomize(header string) ([]KustomizeFile, error) {
+	t := kustomizeTree{header: header}
+
+	base := newKustomization()
+	base.GeneratorOptions = &kustomizeGeneratorOptions{
+		// The secrets are referenced by name from the other resources
+		// therefore their names must be preserved.
+		DisableNameSuffixHash: true,
+	}
+
+	add := func(kind string, name string, obj interface{}) error {
+		filename := resourceFileName(kind, name)
+		base.Resources = append(base.Resources, filename)
+		return t.addYAML(path.Join(kustomizeBaseDir, filename), obj)
+	}
+
+	err := add(d.Namespace.Kind, d.Namespace.Name, d.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range d.Secrets {
+		data := make(map[string][]byte, len(s.Data)+len(s.StringData))
+		for key, value := range s.Data {
+			data[key] = value
+		}
+		for key, value := range s.StringData {
+			data[key] = []byte(value)
+		}
+
+		base.SecretGenerator = append(base.SecretGenerator, t.secretGenerator(*s, data))
+	}
+
+	for _, s := range d.IncompleteSecrets {
+		data := make(map[string][]byte, len(s.Data)+len(s.StringData))
+		for key, value := range s.Data {
+			data[key] = []byte(value)
+		}
+		for key, value := range s.StringData {
+			data[key] = []byte(value)
+		}
+
+		meta := v1.Secret{ObjectMeta: s.ObjectMeta, Type: s.Type}
+		base.SecretGenerator = append(base.SecretGenerator, t.secretGenerator(meta, data))
+	}
+
+	err = add(d.System.Kind, d.System.Name, d.System)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range d.PlatformNetworks {
+		if err = add(n.Kind, n.Name, n); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range d.AddressPools {
+		if err = add(n.Kind, n.Name, n); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range d.DataNetworks {
+		if err = add(n.Kind, n.Name, n); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range d.PtpInstances {
+		if err = add(n.Kind, n.Name, n); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range d.PtpInterfaces {
+		if err = add(n.Kind, n.Name, n); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range d.Profiles {
+		if err = add(p.Kind, p.Name, p); err != nil {
+			return nil, err
+		}
+	}
+
+	overlay := newKustomization()
+	overlay.Resources = []string{path.Join("..", "..", kustomizeBaseDir)}
+
+	for _, h := range d.Hosts {
+		filename := resourceFileName(h.Kind, h.Name)
+		overlay.Resources = append(overlay.Resources, filename)
+
+		err = t.addYAML(path.Join(kustomizeOverlayDir, filename), h)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	root := newKustomization()
+	root.Resources = []string{kustomizeOverlayDir}
+
+	for dir, k := range map[string]*kustomization{
+		kustomizeBaseDir:    base,
+		kustomizeOverlayDir: overlay,
+		"":                  root,
+	} {
+		err = t.addYAML(path.Join(dir, KustomizationFileName), k)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(t.files, func(i, j int) bool {
+		return t.files[i].Path < t.files[j].Path
+	})
+
+	return t.files, nil
+}
+
+// CheckKustomizeDirectory ensures that a kustomize directory tree can be
+// stored below the given directory.  The directory must either not exist or be
+// empty so that files left over from a previous run are never mixed with the
+// new output.
+func CheckKustomizeDirectory(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		err = perrors.Wrapf(err, "failed to read output directory %q", dir)
+		return err
+	} else if len(entries) > 0 {
+		return perrors.Errorf("output directory %q is not empty", dir)
+	}
+
+	return nil
+}
+
+// WriteKustomizeFiles stores a kustomize directory tree below the given
+// directory.
+func WriteKustomizeFiles(dir string, files []KustomizeFile) error {
+	err := CheckKustomizeDirectory(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(f.Path))
+
+		err = os.MkdirAll(filepath.Dir(filename), 0755)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to create directory for %q", filename)
+			return err
+		}
+
+		err = os.WriteFile(filename, f.Data, 0600)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to write %q", filename)
+			return err
+		}
+	}
+
+	return nil
+}
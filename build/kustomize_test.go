@@ -0,0 +1,162 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Kustomize utilities", func() {
+	newDeployment := func() *Deployment {
+		namespace, _ := starlingxv1.NewNamespace("fakens")
+		meta := func(name string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{Name: name, Namespace: "fakens"}
+		}
+		typeMeta := func(kind string) metav1.TypeMeta {
+			return metav1.TypeMeta{APIVersion: starlingxv1.APIVersion, Kind: kind}
+		}
+
+		return &Deployment{
+			Namespace: *namespace,
+			Secrets: []*v1.Secret{{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindSecret},
+				ObjectMeta: meta("bmc-secret"),
+				Type:       v1.SecretTypeBasicAuth,
+				Data: map[string][]byte{
+					v1.BasicAuthUsernameKey: []byte("admin"),
+					v1.BasicAuthPasswordKey: []byte("secret"),
+				},
+			}},
+			IncompleteSecrets: []*IncompleteSecret{{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindSecret},
+				ObjectMeta: meta("https-cert"),
+				Type:       v1.SecretTypeTLS,
+				Data:       map[string]string{v1.TLSCertKey: "replace me"},
+			}},
+			System: starlingxv1.System{
+				TypeMeta:   typeMeta("System"),
+				ObjectMeta: meta("vbox"),
+			},
+			DataNetworks: []*starlingxv1.DataNetwork{{
+				TypeMeta:   typeMeta("DataNetwork"),
+				ObjectMeta: meta("group0-data0"),
+			}},
+			Profiles: []*starlingxv1.HostProfile{{
+				TypeMeta:   typeMeta("HostProfile"),
+				ObjectMeta: meta("controller-0-profile"),
+			}},
+			Hosts: []*starlingxv1.Host{{
+				TypeMeta:   typeMeta("Host"),
+				ObjectMeta: meta("controller-0"),
+				Spec:       starlingxv1.HostSpec{Profile: "controller-0-profile"},
+			}},
+		}
+	}
+
+	Describe("ToKustomize", func() {
+		Context("when a deployment is converted", func() {
+			It("should produce a base and an overlay with one file per resource", func() {
+				files, err := newDeployment().ToKustomize("# header\n")
+				Expect(err).ToNot(HaveOccurred())
+
+				index := make(map[string][]byte)
+				paths := make([]string, 0)
+				for _, f := range files {
+					index[f.Path] = f.Data
+					paths = append(paths, f.Path)
+				}
+
+				Expect(paths).To(Equal([]string{
+					"base/datanetworks/group0-data0.yaml",
+					"base/hostprofiles/controller-0-profile.yaml",
+					"base/kustomization.yaml",
+					"base/namespaces/fakens.yaml",
+					"base/secrets/bmc-secret/password",
+					"base/secrets/bmc-secret/username",
+					"base/secrets/https-cert/tls.crt",
+					"base/systems/vbox.yaml",
+					"kustomization.yaml",
+					"overlays/hosts/hosts/controller-0.yaml",
+					"overlays/hosts/kustomization.yaml",
+				}))
+
+				Expect(string(index["base/secrets/bmc-secret/password"])).To(Equal("secret"))
+				Expect(string(index["base/secrets/https-cert/tls.crt"])).To(Equal("replace me"))
+				Expect(string(index["overlays/hosts/hosts/controller-0.yaml"])).To(HavePrefix("# header\n"))
+
+				base := kustomization{}
+				Expect(yaml.Unmarshal(index["base/kustomization.yaml"], &base)).To(Succeed())
+				Expect(base.Resources).To(Equal([]string{
+					"namespaces/fakens.yaml",
+					"systems/vbox.yaml",
+					"datanetworks/group0-data0.yaml",
+					"hostprofiles/controller-0-profile.yaml",
+				}))
+				Expect(base.GeneratorOptions.DisableNameSuffixHash).To(BeTrue())
+				Expect(base.SecretGenerator).To(Equal([]kustomizeSecretGenerator{
+					{
+						Name:      "bmc-secret",
+						Namespace: "fakens",
+						Type:      string(v1.SecretTypeBasicAuth),
+						Files: []string{
+							"password=secrets/bmc-secret/password",
+							"username=secrets/bmc-secret/username",
+						},
+					},
+					{
+						Name:      "https-cert",
+						Namespace: "fakens",
+						Type:      string(v1.SecretTypeTLS),
+						Files:     []string{"tls.crt=secrets/https-cert/tls.crt"},
+					},
+				}))
+
+				overlay := kustomization{}
+				Expect(yaml.Unmarshal(index["overlays/hosts/kustomization.yaml"], &overlay)).To(Succeed())
+				Expect(overlay.Resources).To(Equal([]string{"../../base", "hosts/controller-0.yaml"}))
+
+				root := kustomization{}
+				Expect(yaml.Unmarshal(index["kustomization.yaml"], &root)).To(Succeed())
+				Expect(root.Resources).To(Equal([]string{"overlays/hosts"}))
+
+				host := starlingxv1.Host{}
+				Expect(yaml.Unmarshal(index["overlays/hosts/hosts/controller-0.yaml"], &host)).To(Succeed())
+				Expect(host.Spec.Profile).To(Equal("controller-0-profile"))
+			})
+		})
+	})
+
+	Describe("WriteKustomizeFiles", func() {
+		Context("when the output directory is empty", func() {
+			It("should write the directory tree", func() {
+				dir := filepath.Join(GinkgoT().TempDir(), "out")
+				files, err := newDeployment().ToKustomize("")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(WriteKustomizeFiles(dir, files)).To(Succeed())
+
+				data, err := os.ReadFile(filepath.Join(dir, "base", "secrets", "bmc-secret", "username"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal("admin"))
+			})
+		})
+
+		Context("when the output directory is not empty", func() {
+			It("should refuse to write", func() {
+				dir := GinkgoT().TempDir()
+				Expect(os.WriteFile(filepath.Join(dir, "stale.yaml"), nil, 0600)).To(Succeed())
+
+				Expect(WriteKustomizeFiles(dir, nil)).ToNot(Succeed())
+			})
+		})
+	})
+})
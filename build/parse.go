@@ -0,0 +1,253 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Kubernetes core kinds that may appear within a deployment configuration
+// file alongside the StarlingX CRD instances.
+const (
+	KindNamespace = "Namespace"
+	KindSecret    = "Secret"
+)
+
+// Document represents a single YAML document extracted from a multi-document
+// deployment configuration file.  The line number is retained so that any
+// errors related to the document can be reported back to the user relative
+// to the original file contents.
+type Document struct {
+	// Line is the 1-based line number at which the document starts.
+	Line int
+
+	// Kind is the resource kind as declared in the document.
+	Kind string
+
+	// Name is the resource name as declared in the document metadata.
+	Name string
+
+	// Namespace is the resource namespace as declared in the document
+	// metadata.
+	Namespace string
+
+	// Data is the raw YAML content of the document.
+	Data []byte
+}
+
+// String returns a short description of the document which is suitable for
+// identifying it in log and error messages.
+func (d Document) String() string {
+	return fmt.Sprintf("%s/%s (line %d)", d.Kind, d.Name, d.Line)
+}
+
+// isEmptyDocument determines whether a document contains anything other than
+// whitespace and comments.
+func isEmptyDocument(lines []string) bool {
+	for _, l := range lines {
+		trimmed := strings.TrimSpace(l)
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newDocument builds a Document from the lines of text that were collected
+// between two YAML separators.  Documents that contain only comments or
+// whitespace are ignored and returned as nil.
+func newDocument(lines []string, line int) (*Document, error) {
+	if isEmptyDocument(lines) {
+		return nil, nil
+	}
+
+	data := []byte(strings.Join(lines, "\n"))
+
+	var obj struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+	}
+
+	err := yaml.Unmarshal(data, &obj)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to parse document at line %d", line)
+		return nil, err
+	}
+
+	if obj.Kind == "" {
+		err = perrors.Errorf("document at line %d does not specify a kind", line)
+		return nil, err
+	}
+
+	doc := Document{
+		Line:      line,
+		Kind:      obj.Kind,
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+		Data:      data,
+	}
+
+	return &doc, nil
+}
+
+// SplitDocuments splits a multi-document YAML stream into its individual
+// documents while keeping track of where each one starts in the original
+// stream.
+func SplitDocuments(data []byte) ([]Document, error) {
+	result := make([]Document, 0)
+	lines := make([]string, 0)
+	start := 1
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		if strings.TrimRight(line, " \t") == "---" {
+			doc, err := newDocument(lines, start)
+			if err != nil {
+				return nil, err
+			} else if doc != nil {
+				result = append(result, *doc)
+			}
+
+			lines = make([]string, 0)
+			start = lineNumber + 1
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		err = perrors.Wrap(err, "failed to read YAML documents")
+		return nil, err
+	}
+
+	doc, err := newDocument(lines, start)
+	if err != nil {
+		return nil, err
+	} else if doc != nil {
+		result = append(result, *doc)
+	}
+
+	return result, nil
+}
+
+// DecodeDocument decodes a single document into an instance of the type that
+// corresponds to its kind.  Secrets that contain plain text placeholders, as
+// rendered for incomplete secrets, are returned as an IncompleteSecret.
+// Documents of unsupported kinds are returned as nil.
+func DecodeDocument(doc Document) (interface{}, error) {
+	var obj interface{}
+
+	switch doc.Kind {
+	case KindNamespace:
+		obj = &v1.Namespace{}
+	case KindSecret:
+		obj = &v1.Secret{}
+	case starlingxv1.KindSystem:
+		obj = &starlingxv1.System{}
+	case starlingxv1.KindPlatformNetwork:
+		obj = &starlingxv1.PlatformNetwork{}
+	case starlingxv1.KindAddressPool:
+		obj = &starlingxv1.AddressPool{}
+	case starlingxv1.KindDataNetwork:
+		obj = &starlingxv1.DataNetwork{}
+	case starlingxv1.KindPTPInstance:
+		obj = &starlingxv1.PtpInstance{}
+	case starlingxv1.KindPTPInterface:
+		obj = &starlingxv1.PtpInterface{}
+	case starlingxv1.KindHostProfile:
+		obj = &starlingxv1.HostProfile{}
+	case starlingxv1.KindHost:
+		obj = &starlingxv1.Host{}
+	default:
+		return nil, nil
+	}
+
+	err := yaml.Unmarshal(doc.Data, obj)
+	if err != nil && doc.Kind == KindSecret {
+		// Secrets that could not be retrieved from the system are rendered
+		// with plain text placeholders that cannot be decoded as a regular
+		// secret.
+		obj = &IncompleteSecret{}
+		err = yaml.Unmarshal(doc.Data, obj)
+	}
+
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to decode %s", doc.String())
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// addDocument decodes a single document and stores the resulting object in
+// the appropriate deployment attribute.  Documents of unsupported kinds are
+// ignored.
+func (d *Deployment) addDocument(doc Document) error {
+	obj, err := DecodeDocument(doc)
+	if err != nil {
+		return err
+	}
+
+	switch o := obj.(type) {
+	case *v1.Namespace:
+		d.Namespace = *o
+	case *v1.Secret:
+		d.Secrets = append(d.Secrets, o)
+	case *IncompleteSecret:
+		d.IncompleteSecrets = append(d.IncompleteSecrets, o)
+	case *starlingxv1.System:
+		d.System = *o
+	case *starlingxv1.PlatformNetwork:
+		d.PlatformNetworks = append(d.PlatformNetworks, o)
+	case *starlingxv1.AddressPool:
+		d.AddressPools = append(d.AddressPools, o)
+	case *starlingxv1.DataNetwork:
+		d.DataNetworks = append(d.DataNetworks, o)
+	case *starlingxv1.PtpInstance:
+		d.PtpInstances = append(d.PtpInstances, o)
+	case *starlingxv1.PtpInterface:
+		d.PtpInterfaces = append(d.PtpInterfaces, o)
+	case *starlingxv1.HostProfile:
+		d.Profiles = append(d.Profiles, o)
+	case *starlingxv1.Host:
+		d.Hosts = append(d.Hosts, o)
+	}
+
+	return nil
+}
+
+// ParseDeployment decodes a multi-document deployment configuration file, as
+// produced by the deployment builder, back into a Deployment structure.
+func ParseDeployment(data []byte) (*Deployment, error) {
+	docs, err := SplitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	d := Deployment{}
+	for _, doc := range docs {
+		if err := d.addDocument(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return &d, nil
+}
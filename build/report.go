@@ -0,0 +1,251 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"reflect"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+// Filter stages as named in the filter configuration file.
+const (
+	SystemFilterStage          = "system"
+	ProfileFilterStage         = "profile"
+	HostFilterStage            = "host"
+	PlatformNetworkFilterStage = "platformNetwork"
+)
+
+// Controller0ExclusionReason describes why controller-0 is excluded from
+// static provisioning.
+const Controller0ExclusionReason = "controller-0 exclusion: provisioned dynamically since it is expected to be installed already"
+
+// FilterChange describes a single resource or attribute changed by a filter.
+// Changes to an entire resource do not include a path or values.
+type FilterChange struct {
+	Kind      string         `json:"kind"`
+	Name      string         `json:"name"`
+	Path      string         `json:"path,omitempty"`
+	Operation DeltaOperation `json:"op"`
+	Before    interface{}    `json:"before,omitempty"`
+	After     interface{}    `json:"after,omitempty"`
+}
+
+// FilterReport records the actions of a single filter over the course of a
+// build.
+type FilterReport struct {
+	Name    string         `json:"name"`
+	Stage   string         `json:"stage"`
+	Runs    int            `json:"runs"`
+	Notes   []string       `json:"notes,omitempty"`
+	Changes []FilterChange `json:"changes,omitempty"`
+}
+
+// ExcludedHost describes a host which a filter excluded from being
+// statically provisioned.
+type ExcludedHost struct {
+	Name   string `json:"name"`
+	Filter string `json:"filter"`
+	Reason string `json:"reason"`
+}
+
+// BuildReport is a machine readable account of a build which records what
+// was removed or changed by each filter, along with a summary of the
+// resulting deployment.
+type BuildReport struct {
+	Filters           []*FilterReport `json:"filters"`
+	ExcludedHosts     []ExcludedHost  `json:"excludedHosts,omitempty"`
+	ResourceCounts    map[string]int  `json:"resourceCounts"`
+	IncompleteSecrets []MissingSecret `json:"incompleteSecrets,omitempty"`
+
+	// instances holds the filter instance of each entry in Filters.
+	instances []interface{}
+}
+
+// filterName returns the name of the filter type.
+func filterName(filter interface{}) string {
+	t := reflect.TypeOf(filter)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// newBuildReport creates an empty report entry for each of the configured
+// filters so that filters which did not change anything are listed too.
+func (db *DeploymentBuilder) newBuildReport() *BuildReport {
+	report := &BuildReport{
+		Filters:        make([]*FilterReport, 0),
+		ResourceCounts: make(map[string]int),
+	}
+
+	add := func(filter interface{}, stage string) {
+		if report.entry(filter) != nil {
+			return
+		}
+
+		report.Filters = append(report.Filters, &FilterReport{Name: filterName(filter), Stage: stage})
+		report.instances = append(report.instances, filter)
+	}
+
+	for _, f := range db.systemFilters {
+		add(f, SystemFilterStage)
+	}
+	for _, f := range db.platformNetworkFilters {
+		add(f, PlatformNetworkFilterStage)
+	}
+	for _, f := range db.hostFilters {
+		add(f, HostFilterStage)
+	}
+	for _, f := range db.profileFilters {
+		add(f, ProfileFilterStage)
+	}
+
+	return report
+}
+
+// entry returns the report entry of a filter instance.
+func (r *BuildReport) entry(filter interface{}) *FilterReport {
+	for i, f := range r.instances {
+		if f == filter {
+			return r.Filters[i]
+		}
+	}
+
+	return nil
+}
+
+// reportSnapshot captures the generic representation of the resources of a
+// deployment along with the resources passed directly to a filter, which may
+// not have been added to the deployment yet.
+func reportSnapshot(d *Deployment, targets ...diffResource) (map[string]map[string]interface{}, error) {
+	resources := d.diffResources()
+	for _, t := range targets {
+		resources[t.kind][t.name] = t
+	}
+
+	return genericResources(resources)
+}
+
+// pendingTargets returns the targets which have not been added to the
+// deployment yet.  Targets which are already part of the deployment are
+// compared through it so that filters which remove them are reported.
+func pendingTargets(d *Deployment, targets []diffResource) []diffResource {
+	resources := d.diffResources()
+
+	result := make([]diffResource, 0, len(targets))
+	for _, t := range targets {
+		if _, ok := resources[t.kind][t.name]; !ok {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// runFilter runs a single filter and, if a report is being collected, records
+// the changes that it made to the deployment and to the target resources.
+func (db *DeploymentBuilder) runFilter(filter interface{}, d *Deployment, fn func() error, targets ...diffResource) error {
+	if db.report == nil {
+		return fn()
+	}
+
+	targets = pendingTargets(d, targets)
+
+	before, err := reportSnapshot(d, targets...)
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	if err != nil {
+		return err
+	}
+
+	after, err := reportSnapshot(d, targets...)
+	if err != nil {
+		return err
+	}
+
+	entry := db.report.entry(filter)
+	entry.Runs++
+
+	for _, r := range diffGenericResources(before, after) {
+		if r.Operation != DeltaChanged {
+			entry.Changes = append(entry.Changes, FilterChange{
+				Kind: r.Kind, Name: r.Name, Operation: r.Operation})
+			continue
+		}
+
+		for _, f := range r.Fields {
+			entry.Changes = append(entry.Changes, FilterChange{
+				Kind:      r.Kind,
+				Name:      r.Name,
+				Path:      f.Path,
+				Operation: f.Operation,
+				Before:    f.Expected,
+				After:     f.Observed,
+			})
+		}
+	}
+
+	return nil
+}
+
+// finalizeReport adds the details kept by the filters themselves and the
+// summary of the resulting deployment to the report.
+func (db *DeploymentBuilder) finalizeReport(d *Deployment) {
+	report := db.report
+
+	for i, filter := range report.instances {
+		entry := report.Filters[i]
+
+		if f, ok := filter.(ReportingFilter); ok {
+			entry.Notes = append(entry.Notes, f.Notes()...)
+		}
+
+		if f, ok := filter.(HostExcludingFilter); ok {
+			for _, name := range f.ExcludedHosts() {
+				report.ExcludedHosts = append(report.ExcludedHosts, ExcludedHost{
+					Name: name, Filter: entry.Name, Reason: f.ExclusionReason()})
+			}
+		}
+	}
+
+	counts := report.ResourceCounts
+	counts[KindNamespace] = 1
+	if d.System.Name != "" {
+		counts[starlingxv1.KindSystem] = 1
+	}
+	counts[starlingxv1.KindPlatformNetwork] = len(d.PlatformNetworks)
+	counts[starlingxv1.KindAddressPool] = len(d.AddressPools)
+	counts[starlingxv1.KindDataNetwork] = len(d.DataNetworks)
+	counts[starlingxv1.KindPTPInstance] = len(d.PtpInstances)
+	counts[starlingxv1.KindPTPInterface] = len(d.PtpInterfaces)
+	counts[starlingxv1.KindHostProfile] = len(d.Profiles)
+	counts[starlingxv1.KindHost] = len(d.Hosts)
+	counts[KindSecret] = len(d.Secrets) + len(d.IncompleteSecrets)
+
+	for kind, count := range counts {
+		if count == 0 {
+			delete(counts, kind)
+		}
+	}
+
+	report.IncompleteSecrets = NewSecretsReport(d).MissingSecrets
+}
+
+// EnableReport requests that a build report be collected while building the
+// deployment.  Collecting a report requires comparing the deployment before
+// and after each filter is run and therefore slows down the build.
+func (db *DeploymentBuilder) EnableReport() {
+	db.reporting = true
+}
+
+// Report returns the report collected by the last build or nil if reporting
+// was not enabled.
+func (db *DeploymentBuilder) Report() *BuildReport {
+	return db.report
+}
@@ -0,0 +1,159 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Build report utilities", func() {
+	newBuilder := func() *DeploymentBuilder {
+		db := &DeploymentBuilder{
+			progressWriter: io.Discard,
+			systemFilters:  defaultSystemFilters(),
+			hostFilters:    []HostFilter{NewController0Filter()},
+			profileFilters: []ProfileFilter{NewInterfaceNamingFilter(), NewConsoleNameFilter()},
+		}
+		db.EnableReport()
+		db.report = db.newBuildReport()
+		db.resetProfileFilters()
+		return db
+	}
+
+	Describe("runFilter", func() {
+		It("should record the changes made by each filter", func() {
+			db := newBuilder()
+			d := &Deployment{}
+
+			system := &starlingxv1.System{
+				ObjectMeta: metav1.ObjectMeta{Name: "vbox"},
+				Spec: starlingxv1.SystemSpec{
+					ServiceParameters: starlingxv1.ServiceParameterList{{
+						Service:    common.DefaultParameters[0].Service,
+						Section:    common.DefaultParameters[0].Section,
+						ParamName:  common.DefaultParameters[0].ParamName,
+						ParamValue: "3600",
+					}},
+				},
+			}
+			Expect(db.filterSystem(system, d)).To(Succeed())
+
+			profile := &starlingxv1.HostProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "controller-0-profile"},
+				Spec: starlingxv1.HostProfileSpec{
+					Interfaces: &starlingxv1.InterfaceInfo{
+						Ethernet: starlingxv1.EthernetList{{
+							CommonInterfaceInfo: starlingxv1.CommonInterfaceInfo{
+								Name:             "enp0s3",
+								PlatformNetworks: starlingxv1.PlatformNetworkItemList{"oam"},
+							},
+						}},
+					},
+				},
+			}
+			host := &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "controller-0"},
+				Spec:       starlingxv1.HostSpec{Overrides: &starlingxv1.HostProfileSpec{}},
+			}
+			Expect(db.filterHost(profile, host, d)).To(Succeed())
+			Expect(db.filterHostProfile(profile, d)).To(Succeed())
+
+			d.System = *system
+			d.Hosts = []*starlingxv1.Host{host}
+			d.Profiles = []*starlingxv1.HostProfile{profile}
+			db.finalizeReport(d)
+
+			report := db.Report()
+			Expect(report.Filters).To(HaveLen(4))
+
+			Expect(report.Filters[0].Name).To(Equal("ServiceParameterFilter"))
+			Expect(report.Filters[0].Stage).To(Equal(SystemFilterStage))
+			Expect(report.Filters[0].Runs).To(Equal(1))
+			Expect(report.Filters[0].Changes).To(HaveLen(1))
+			Expect(report.Filters[0].Changes[0].Kind).To(Equal(starlingxv1.KindSystem))
+			Expect(report.Filters[0].Changes[0].Path).To(Equal("spec.serviceParameters"))
+			Expect(report.Filters[0].Changes[0].Operation).To(Equal(DeltaRemoved))
+
+			Expect(report.Filters[1].Name).To(Equal("Controller0Filter"))
+			Expect(report.Filters[1].Changes).To(ContainElement(FilterChange{
+				Kind:      starlingxv1.KindHost,
+				Name:      "controller-0",
+				Path:      "spec.overrides.provisioningMode",
+				Operation: DeltaAdded,
+				After:     string(starlingxv1.ProvioningModeDynamic),
+			}))
+			Expect(report.ExcludedHosts).To(Equal([]ExcludedHost{{
+				Name: "controller-0", Filter: "Controller0Filter", Reason: Controller0ExclusionReason,
+			}}))
+
+			Expect(report.Filters[2].Name).To(Equal("InterfaceNamingFilter"))
+			Expect(report.Filters[2].Stage).To(Equal(ProfileFilterStage))
+			Expect(report.Filters[2].Notes).To(Equal([]string{
+				`controller-0-profile: renamed interface "enp0s3" to "oam0"`,
+			}))
+			paths := make(map[string]DeltaOperation)
+			for _, c := range report.Filters[2].Changes {
+				paths[c.Path] = c.Operation
+			}
+			Expect(paths).To(Equal(map[string]DeltaOperation{
+				"spec.interfaces.ethernet[name=enp0s3]": DeltaRemoved,
+				"spec.interfaces.ethernet[name=oam0]":   DeltaAdded,
+			}))
+
+			Expect(report.Filters[3].Name).To(Equal("ConsoleNameFilter"))
+			Expect(report.Filters[3].Runs).To(Equal(1))
+			Expect(report.Filters[3].Changes).To(BeEmpty())
+
+			Expect(report.ResourceCounts).To(Equal(map[string]int{
+				KindNamespace:               1,
+				starlingxv1.KindSystem:      1,
+				starlingxv1.KindHostProfile: 1,
+				starlingxv1.KindHost:        1,
+			}))
+		})
+	})
+
+	Describe("filterPlatformNetworks", func() {
+		It("should record the platform networks removed by each filter", func() {
+			db := &DeploymentBuilder{
+				progressWriter:         io.Discard,
+				platformNetworkFilters: []PlatformNetworkFilter{NewCoreNetworkFilter()},
+			}
+			db.EnableReport()
+			db.report = db.newBuildReport()
+
+			d := &Deployment{}
+			for _, name := range []string{oamNetwork, "storage"} {
+				net := &starlingxv1.PlatformNetwork{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       starlingxv1.PlatformNetworkSpec{Type: name},
+				}
+				d.PlatformNetworks = append(d.PlatformNetworks, net)
+				Expect(db.filterPlatformNetworks(net, d)).To(Succeed())
+			}
+
+			report := db.Report()
+			Expect(report.Filters).To(HaveLen(1))
+			Expect(report.Filters[0].Name).To(Equal("CoreNetworkFilter"))
+			Expect(report.Filters[0].Stage).To(Equal(PlatformNetworkFilterStage))
+			Expect(report.Filters[0].Runs).To(Equal(2))
+			Expect(report.Filters[0].Changes).To(Equal([]FilterChange{{
+				Kind: starlingxv1.KindPlatformNetwork, Name: oamNetwork, Operation: DeltaRemoved,
+			}}))
+		})
+	})
+
+	Describe("Report", func() {
+		It("should be nil unless reporting was enabled", func() {
+			db := &DeploymentBuilder{progressWriter: io.Discard}
+			Expect(db.Report()).To(BeNil())
+		})
+	})
+})
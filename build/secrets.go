@@ -0,0 +1,467 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Secret source prefixes.  A secret source is specified as "<prefix>:<arg>".
+const (
+	DirectorySecretSourcePrefix = "dir"
+	EnvSecretSourcePrefix       = "env"
+	KeyringSecretSourcePrefix   = "keyring"
+)
+
+// DefaultSecretEnvPrefix is the prefix of the environment variables consulted
+// by an environment secret source when no prefix is specified.
+const DefaultSecretEnvPrefix = "DEPLOYCTL_SECRET_"
+
+// KeyringPassphraseEnv is the environment variable which holds the passphrase
+// used to decrypt a keyring.
+const KeyringPassphraseEnv = "DEPLOYCTL_KEYRING_PASSPHRASE"
+
+// SecretSource defines an interface from which concrete secret sources can be
+// defined.  The purpose of a secret source is to supply the attributes of
+// secrets which cannot be retrieved from the system, such as passwords and
+// private keys.
+type SecretSource interface {
+	// Lookup returns the attributes available for the named secret.  The
+	// attributes which are missing are provided as a hint but sources may
+	// return any attributes that they hold.  A secret which is not known to
+	// the source returns an empty result.  No attributes are provided for
+	// secrets whose attribute names are not known.
+	Lookup(name string, keys []string) (map[string][]byte, error)
+
+	// String returns a description of the source suitable for progress
+	// messages.
+	String() string
+}
+
+// DirectorySecretSource defines a secret source which reads each secret
+// attribute from a file named after the attribute within a directory named
+// after the secret.  This is the same layout as the secret files produced by
+// the kustomize output format.
+type DirectorySecretSource struct {
+	Path string
+}
+
+func NewDirectorySecretSource(path string) *DirectorySecretSource {
+	return &DirectorySecretSource{Path: path}
+}
+
+func (in *DirectorySecretSource) Lookup(name string, keys []string) (map[string][]byte, error) {
+	dir := filepath.Join(in.Path, name)
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		err = perrors.Wrapf(err, "failed to read secret directory %q", dir)
+		return nil, err
+	}
+
+	result := make(map[string][]byte)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to read secret file %q", entry.Name())
+			return nil, err
+		}
+
+		result[entry.Name()] = data
+	}
+
+	return result, nil
+}
+
+func (in *DirectorySecretSource) String() string {
+	return fmt.Sprintf("directory %q", in.Path)
+}
+
+// envNameRegex matches the characters which are not allowed in an environment
+// variable name.
+var envNameRegex = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+// EnvSecretSource defines a secret source which reads each missing secret
+// attribute from an environment variable named "<prefix><secret>_<attribute>"
+// where the secret and attribute names are converted to upper case and any
+// characters not allowed in a variable name are replaced with underscores.
+// Since the attribute names cannot be recovered from the variable names this
+// source cannot complete secrets whose attribute names are not known.
+type EnvSecretSource struct {
+	Prefix string
+}
+
+func NewEnvSecretSource(prefix string) *EnvSecretSource {
+	return &EnvSecretSource{Prefix: prefix}
+}
+
+// SecretEnvName returns the name of the environment variable consulted for a
+// secret attribute.
+func (in *EnvSecretSource) SecretEnvName(name string, key string) string {
+	return envNameRegex.ReplaceAllString(strings.ToUpper(in.Prefix+name+"_"+key), "_")
+}
+
+func (in *EnvSecretSource) Lookup(name string, keys []string) (map[string][]byte, error) {
+	result := make(map[string][]byte)
+	for _, key := range keys {
+		if value, ok := os.LookupEnv(in.SecretEnvName(name, key)); ok {
+			result[key] = []byte(value)
+		}
+	}
+
+	return result, nil
+}
+
+func (in *EnvSecretSource) String() string {
+	return fmt.Sprintf("environment variables %q", in.Prefix+"*")
+}
+
+// Keyring encryption parameters.
+const (
+	keyringVersion    = 1
+	keyringKDF        = "pbkdf2-sha256"
+	keyringIterations = 600000
+	keyringKeyLength  = 32
+	keyringSaltLength = 16
+)
+
+// EncryptedKeyring defines the on-disk format of an encrypted keyring.  The
+// plain text content is a YAML map of secret names to maps of attribute names
+// to attribute values.  It is encrypted with AES-256-GCM using a key derived
+// from a passphrase.
+type EncryptedKeyring struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// keyringCipher creates the cipher used to encrypt or decrypt a keyring.
+func keyringCipher(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, perrors.New("keyring passphrase must not be blank")
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, keyringKeyLength)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to derive keyring key")
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// SealKeyring encrypts the plain text content of a keyring.  The content is
+// decoded first so that an invalid keyring is never sealed.
+func SealKeyring(plaintext []byte, passphrase string) ([]byte, error) {
+	secrets := make(map[string]map[string]string)
+
+	err := yaml.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse keyring content")
+		return nil, err
+	}
+
+	keyring := EncryptedKeyring{
+		Version:    keyringVersion,
+		KDF:        keyringKDF,
+		Iterations: keyringIterations,
+		Salt:       make([]byte, keyringSaltLength),
+	}
+
+	if _, err = rand.Read(keyring.Salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := keyringCipher(passphrase, keyring.Salt, keyring.Iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	keyring.Nonce = make([]byte, aead.NonceSize())
+	if _, err = rand.Read(keyring.Nonce); err != nil {
+		return nil, err
+	}
+
+	keyring.Ciphertext = aead.Seal(nil, keyring.Nonce, plaintext, nil)
+
+	return yaml.Marshal(keyring)
+}
+
+// OpenKeyring decrypts an encrypted keyring and returns its secrets.
+func OpenKeyring(data []byte, passphrase string) (map[string]map[string]string, error) {
+	keyring := EncryptedKeyring{}
+
+	err := yaml.Unmarshal(data, &keyring)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse keyring")
+		return nil, err
+	}
+
+	if keyring.Version != keyringVersion || keyring.KDF != keyringKDF {
+		return nil, perrors.Errorf("unsupported keyring version %d with %q key derivation",
+			keyring.Version, keyring.KDF)
+	}
+
+	aead, err := keyringCipher(passphrase, keyring.Salt, keyring.Iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyring.Nonce) != aead.NonceSize() {
+		return nil, perrors.New("invalid keyring nonce")
+	}
+
+	plaintext, err := aead.Open(nil, keyring.Nonce, keyring.Ciphertext, nil)
+	if err != nil {
+		return nil, perrors.New("failed to decrypt keyring; the passphrase may be incorrect")
+	}
+
+	secrets := make(map[string]map[string]string)
+
+	err = yaml.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse keyring content")
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// KeyringSecretSource defines a secret source which reads secret attributes
+// from an encrypted keyring file.
+type KeyringSecretSource struct {
+	Path    string
+	secrets map[string]map[string]string
+}
+
+// NewKeyringSecretSource opens an encrypted keyring file using the provided
+// passphrase.
+func NewKeyringSecretSource(path string, passphrase string) (*KeyringSecretSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to read keyring %q", path)
+		return nil, err
+	}
+
+	secrets, err := OpenKeyring(data, passphrase)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to open keyring %q", path)
+		return nil, err
+	}
+
+	return &KeyringSecretSource{Path: path, secrets: secrets}, nil
+}
+
+func (in *KeyringSecretSource) Lookup(name string, keys []string) (map[string][]byte, error) {
+	result := make(map[string][]byte)
+	for key, value := range in.secrets[name] {
+		result[key] = []byte(value)
+	}
+
+	return result, nil
+}
+
+func (in *KeyringSecretSource) String() string {
+	return fmt.Sprintf("keyring %q", in.Path)
+}
+
+// ParseSecretSource creates a secret source from its "<prefix>:<arg>"
+// specification.  The argument of an environment source is optional and
+// defaults to DefaultSecretEnvPrefix.  The passphrase of a keyring source is
+// read from the KeyringPassphraseEnv environment variable.
+func ParseSecretSource(spec string) (SecretSource, error) {
+	prefix, arg, _ := strings.Cut(spec, ":")
+
+	switch prefix {
+	case DirectorySecretSourcePrefix:
+		if arg == "" {
+			return nil, perrors.Errorf("secret source %q requires a directory", spec)
+		}
+		return NewDirectorySecretSource(arg), nil
+
+	case EnvSecretSourcePrefix:
+		if arg == "" {
+			arg = DefaultSecretEnvPrefix
+		}
+		return NewEnvSecretSource(arg), nil
+
+	case KeyringSecretSourcePrefix:
+		if arg == "" {
+			return nil, perrors.Errorf("secret source %q requires a keyring file", spec)
+		}
+		return NewKeyringSecretSource(arg, os.Getenv(KeyringPassphraseEnv))
+	}
+
+	return nil, perrors.Errorf("unknown secret source %q; expected %q, %q or %q",
+		spec, DirectorySecretSourcePrefix+":<path>", EnvSecretSourcePrefix+"[:<prefix>]",
+		KeyringSecretSourcePrefix+":<path>")
+}
+
+// MissingKeys returns the sorted list of attributes which still hold the
+// incomplete secret placeholder.
+func (in *IncompleteSecret) MissingKeys() []string {
+	result := make([]string, 0)
+	for key, value := range in.Data {
+		if value == IncompleteSecretWarning {
+			result = append(result, key)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// resolveSecret fills in the missing attributes of an incomplete secret from
+// the attributes supplied by a source.  An empty value removes an attribute
+// which does not apply to the secret, such as an optional CA certificate.
+// Secrets whose attribute names are not known take all supplied attributes.
+// Supplied attributes are moved to the string data since the data of an
+// incomplete secret is rendered as plain text.
+func resolveSecret(secret *IncompleteSecret, data map[string][]byte) {
+	if _, ok := secret.Data[incompleteSecretDataKey]; ok && len(data) > 0 {
+		delete(secret.Data, incompleteSecretDataKey)
+		for key := range data {
+			secret.Data[key] = IncompleteSecretWarning
+		}
+	}
+
+	for _, key := range secret.MissingKeys() {
+		value, ok := data[key]
+		if !ok {
+			continue
+		}
+
+		delete(secret.Data, key)
+		if len(value) > 0 {
+			if secret.StringData == nil {
+				secret.StringData = make(map[string]string)
+			}
+			secret.StringData[key] = string(value)
+		}
+	}
+}
+
+// resolveIncompleteSecrets consults each secret source in turn to fill in the
+// incomplete secrets.  Secrets which no longer have any missing attributes are
+// converted to regular secrets.
+func (db *DeploymentBuilder) resolveIncompleteSecrets(d *Deployment) error {
+	for _, source := range db.secretSources {
+		for _, secret := range d.IncompleteSecrets {
+			missing := secret.MissingKeys()
+			if len(missing) == 0 {
+				continue
+			}
+
+			// The placeholder of a secret whose attribute names are not
+			// known is not a real attribute name so it is not requested.
+			keys := make([]string, 0, len(missing))
+			for _, key := range missing {
+				if key != incompleteSecretDataKey {
+					keys = append(keys, key)
+				}
+			}
+
+			data, err := source.Lookup(secret.Name, keys)
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to look up secret %q from %s",
+					secret.Name, source.String())
+				return err
+			}
+
+			// Never complete a secret with the placeholder attribute.
+			delete(data, incompleteSecretDataKey)
+
+			if len(data) > 0 {
+				db.progressUpdate("...Reading secret %q from %s\n", secret.Name, source.String())
+				resolveSecret(secret, data)
+			}
+		}
+	}
+
+	remaining := make([]*IncompleteSecret, 0)
+	for _, secret := range d.IncompleteSecrets {
+		if len(secret.MissingKeys()) > 0 {
+			remaining = append(remaining, secret)
+			continue
+		}
+
+		result := v1.Secret{
+			TypeMeta:   secret.TypeMeta,
+			ObjectMeta: secret.ObjectMeta,
+			Type:       secret.Type,
+			Data:       make(map[string][]byte, len(secret.Data)+len(secret.StringData)),
+		}
+
+		for key, value := range secret.Data {
+			result.Data[key] = []byte(value)
+		}
+		for key, value := range secret.StringData {
+			result.Data[key] = []byte(value)
+		}
+
+		d.Secrets = append(d.Secrets, &result)
+	}
+
+	d.IncompleteSecrets = remaining
+
+	return nil
+}
+
+// MissingSecret describes a secret which could not be completed.
+type MissingSecret struct {
+	Name        string   `json:"name"`
+	Namespace   string   `json:"namespace"`
+	Type        string   `json:"type"`
+	MissingKeys []string `json:"missingKeys"`
+}
+
+// SecretsReport lists the secrets which must still be completed by hand.
+type SecretsReport struct {
+	MissingSecrets []MissingSecret `json:"missingSecrets"`
+}
+
+// NewSecretsReport creates a report of the incomplete secrets of a deployment.
+func NewSecretsReport(d *Deployment) *SecretsReport {
+	report := SecretsReport{MissingSecrets: make([]MissingSecret, 0)}
+
+	for _, secret := range d.IncompleteSecrets {
+		report.MissingSecrets = append(report.MissingSecrets, MissingSecret{
+			Name:        secret.Name,
+			Namespace:   secret.Namespace,
+			Type:        string(secret.Type),
+			MissingKeys: secret.MissingKeys(),
+		})
+	}
+
+	return &report
+}
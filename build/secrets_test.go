@@ -0,0 +1,237 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Secret source utilities", func() {
+	newDeployment := func() *Deployment {
+		bmc, err := starlingxv1.NewBMSecret("bmc-secret", "fakens", "admin")
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := starlingxv1.NewCertificateSecret("https-cert", "fakens")
+		Expect(err).ToNot(HaveOccurred())
+
+		return &Deployment{
+			IncompleteSecrets: []*IncompleteSecret{
+				parseIncompleteSecret(bmc),
+				parseIncompleteSecret(cert),
+			},
+		}
+	}
+
+	Describe("IncompleteSecret", func() {
+		It("should list the missing attributes", func() {
+			d := newDeployment()
+			Expect(d.IncompleteSecrets[0].MissingKeys()).To(Equal([]string{v1.BasicAuthPasswordKey}))
+			Expect(d.IncompleteSecrets[1].MissingKeys()).To(Equal([]string{
+				v1.ServiceAccountRootCAKey, v1.TLSCertKey, v1.TLSPrivateKeyKey,
+			}))
+		})
+	})
+
+	Describe("resolveIncompleteSecrets", func() {
+		Context("when sources supply the missing attributes", func() {
+			It("should complete the secrets and report the remainder", func() {
+				dir := GinkgoT().TempDir()
+				Expect(os.MkdirAll(filepath.Join(dir, "https-cert"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(dir, "https-cert", v1.TLSCertKey), []byte("cert"), 0600)).To(Succeed())
+
+				GinkgoT().Setenv("TEST_SECRET_BMC_SECRET_PASSWORD", "s3cret")
+				GinkgoT().Setenv("TEST_SECRET_HTTPS_CERT_TLS_CRT", "ignored")
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				db.AddSecretSources([]SecretSource{
+					NewDirectorySecretSource(dir),
+					NewEnvSecretSource("TEST_SECRET_"),
+				})
+
+				d := newDeployment()
+				Expect(db.resolveIncompleteSecrets(d)).To(Succeed())
+
+				Expect(d.Secrets).To(HaveLen(1))
+				Expect(d.Secrets[0].Name).To(Equal("bmc-secret"))
+				Expect(d.Secrets[0].Type).To(Equal(v1.SecretTypeBasicAuth))
+				Expect(d.Secrets[0].Data).To(Equal(map[string][]byte{
+					v1.BasicAuthUsernameKey: []byte("admin"),
+					v1.BasicAuthPasswordKey: []byte("s3cret"),
+				}))
+
+				Expect(d.IncompleteSecrets).To(HaveLen(1))
+				Expect(d.IncompleteSecrets[0].Data).ToNot(HaveKey(v1.TLSCertKey))
+				Expect(d.IncompleteSecrets[0].StringData[v1.TLSCertKey]).To(Equal("cert"))
+
+				report := NewSecretsReport(d)
+				Expect(report.MissingSecrets).To(Equal([]MissingSecret{{
+					Name:        "https-cert",
+					Namespace:   "fakens",
+					Type:        string(v1.SecretTypeTLS),
+					MissingKeys: []string{v1.ServiceAccountRootCAKey, v1.TLSPrivateKeyKey},
+				}}))
+			})
+
+			It("should remove attributes supplied with empty values", func() {
+				dir := GinkgoT().TempDir()
+				secretDir := filepath.Join(dir, "https-cert")
+				Expect(os.MkdirAll(secretDir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, v1.TLSCertKey), []byte("cert"), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, v1.TLSPrivateKeyKey), []byte("key"), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, v1.ServiceAccountRootCAKey), nil, 0600)).To(Succeed())
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				db.AddSecretSources([]SecretSource{NewDirectorySecretSource(dir)})
+
+				d := newDeployment()
+				Expect(db.resolveIncompleteSecrets(d)).To(Succeed())
+
+				Expect(d.Secrets).To(HaveLen(1))
+				Expect(d.Secrets[0].Data).To(Equal(map[string][]byte{
+					v1.TLSCertKey:       []byte("cert"),
+					v1.TLSPrivateKeyKey: []byte("key"),
+				}))
+			})
+		})
+
+		Context("when a partly resolved secret is rendered", func() {
+			It("should write the supplied attributes as string data", func() {
+				GinkgoT().Setenv("TEST_SECRET_HTTPS_CERT_TLS_CRT", "cert")
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				db.AddSecretSources([]SecretSource{NewEnvSecretSource("TEST_SECRET_")})
+
+				d := newDeployment()
+				d.Namespace = v1.Namespace{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindNamespace},
+					ObjectMeta: metav1.ObjectMeta{Name: "fakens"},
+				}
+				d.System = starlingxv1.System{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "starlingx.windriver.com/v1", Kind: starlingxv1.KindSystem},
+					ObjectMeta: metav1.ObjectMeta{Name: "vbox", Namespace: "fakens"},
+				}
+				Expect(db.resolveIncompleteSecrets(d)).To(Succeed())
+
+				out, err := d.ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				docs, err := SplitDocuments([]byte(out))
+				Expect(err).ToNot(HaveOccurred())
+
+				var rendered map[string]interface{}
+				for _, doc := range docs {
+					if doc.Kind == KindSecret && doc.Name == "https-cert" {
+						Expect(yaml.Unmarshal(doc.Data, &rendered)).To(Succeed())
+					}
+				}
+				Expect(rendered).ToNot(BeNil())
+				Expect(rendered["stringData"]).To(Equal(map[string]interface{}{v1.TLSCertKey: "cert"}))
+				Expect(rendered["data"]).ToNot(HaveKey(v1.TLSCertKey))
+
+				parsed, err := ParseDeployment([]byte(out))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed.IncompleteSecrets).To(HaveLen(2))
+				Expect(parsed.IncompleteSecrets[1].Name).To(Equal("https-cert"))
+				Expect(parsed.IncompleteSecrets[1].StringData).To(Equal(map[string]string{v1.TLSCertKey: "cert"}))
+				Expect(parsed.IncompleteSecrets[1].MissingKeys()).To(Equal([]string{
+					v1.ServiceAccountRootCAKey, v1.TLSPrivateKeyKey,
+				}))
+			})
+		})
+
+		Context("when the attribute names of a secret are not known", func() {
+			newOpaqueDeployment := func() *Deployment {
+				secret := &v1.Secret{Type: v1.SecretTypeOpaque}
+				secret.Name = "opaque-secret"
+				secret.Namespace = "fakens"
+				return &Deployment{IncompleteSecrets: []*IncompleteSecret{parseIncompleteSecret(secret)}}
+			}
+
+			It("should not look up the placeholder attribute", func() {
+				GinkgoT().Setenv("TEST_SECRET_OPAQUE_SECRET_FAKE_DATA", "value")
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				db.AddSecretSources([]SecretSource{NewEnvSecretSource("TEST_SECRET_")})
+
+				d := newOpaqueDeployment()
+				Expect(db.resolveIncompleteSecrets(d)).To(Succeed())
+
+				Expect(d.Secrets).To(BeEmpty())
+				Expect(d.IncompleteSecrets).To(HaveLen(1))
+				Expect(d.IncompleteSecrets[0].MissingKeys()).To(Equal([]string{incompleteSecretDataKey}))
+			})
+
+			It("should take the real attributes supplied by the source", func() {
+				dir := GinkgoT().TempDir()
+				secretDir := filepath.Join(dir, "opaque-secret")
+				Expect(os.MkdirAll(secretDir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, "token"), []byte("abc"), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, incompleteSecretDataKey), []byte("stale"), 0600)).To(Succeed())
+
+				db := &DeploymentBuilder{progressWriter: io.Discard}
+				db.AddSecretSources([]SecretSource{NewDirectorySecretSource(dir)})
+
+				d := newOpaqueDeployment()
+				Expect(db.resolveIncompleteSecrets(d)).To(Succeed())
+
+				Expect(d.IncompleteSecrets).To(BeEmpty())
+				Expect(d.Secrets).To(HaveLen(1))
+				Expect(d.Secrets[0].Data).To(Equal(map[string][]byte{"token": []byte("abc")}))
+			})
+		})
+	})
+
+	Describe("Keyring", func() {
+		content := []byte("bmc-secret:\n  password: s3cret\n")
+
+		It("should decrypt a sealed keyring with the same passphrase", func() {
+			sealed, err := SealKeyring(content, "passphrase")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(sealed)).ToNot(ContainSubstring("s3cret"))
+
+			filename := filepath.Join(GinkgoT().TempDir(), "keyring.yaml")
+			Expect(os.WriteFile(filename, sealed, 0600)).To(Succeed())
+
+			source, err := NewKeyringSecretSource(filename, "passphrase")
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := source.Lookup("bmc-secret", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{"password": []byte("s3cret")}))
+		})
+
+		It("should reject the wrong passphrase", func() {
+			sealed, err := SealKeyring(content, "passphrase")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = OpenKeyring(sealed, "wrong")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ParseSecretSource", func() {
+		It("should create a source for each supported prefix", func() {
+			source, err := ParseSecretSource("dir:/tmp/secrets")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source).To(Equal(NewDirectorySecretSource("/tmp/secrets")))
+
+			source, err = ParseSecretSource("env")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source).To(Equal(NewEnvSecretSource(DefaultSecretEnvPrefix)))
+		})
+
+		It("should reject unknown sources", func() {
+			_, err := ParseSecretSource("vault:secret/data")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,249 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+)
+
+// SnapshotVersion is the version of the snapshot archive format.  It must be
+// incremented whenever an incompatible change is made to the format.
+const SnapshotVersion = 1
+
+// SnapshotEntry records a single response returned by the system API.
+type SnapshotEntry struct {
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path is the request path, including the query string, relative to the
+	// system API endpoint.
+	Path string `json:"path"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"status"`
+
+	// ContentType is the content type of the response.
+	ContentType string `json:"contentType,omitempty"`
+
+	// Body is the content of the response.
+	Body string `json:"body"`
+}
+
+// Snapshot is an archive of the system API responses used to build a
+// deployment.  It allows the deployment builder to be re-run at a later time
+// without access to the system.
+type Snapshot struct {
+	// Version is the version of the snapshot archive format.
+	Version int `json:"version"`
+
+	// Created is the time at which the snapshot was captured.
+	Created time.Time `json:"created"`
+
+	// Endpoint is the system API endpoint from which the snapshot was
+	// captured.  It is recorded for informational purposes only.
+	Endpoint string `json:"endpoint"`
+
+	// Entries is the list of recorded responses.
+	Entries []SnapshotEntry `json:"entries"`
+}
+
+// snapshotKey returns the key used to look up a recorded response.
+func snapshotKey(method, path string) string {
+	return method + " " + path
+}
+
+// Write stores the snapshot archive to the given writer in compressed form.
+func (s *Snapshot) Write(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+
+	encoder := json.NewEncoder(zw)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(s)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to encode snapshot")
+		return err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		err = perrors.Wrap(err, "failed to compress snapshot")
+		return err
+	}
+
+	return nil
+}
+
+// ReadSnapshot loads a snapshot archive from the given reader.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to decompress snapshot")
+		return nil, err
+	}
+
+	defer func() { _ = zr.Close() }()
+
+	s := Snapshot{}
+	err = json.NewDecoder(zr).Decode(&s)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to decode snapshot")
+		return nil, err
+	}
+
+	if s.Version != SnapshotVersion {
+		err = perrors.Errorf("unsupported snapshot version %d; expected %d",
+			s.Version, SnapshotVersion)
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// SnapshotRecorder is an HTTP transport which records the responses to all
+// requests sent to the system API endpoint.  Requests sent to any other
+// endpoint, such as the identity service, are passed through unrecorded.
+type SnapshotRecorder struct {
+	base     http.RoundTripper
+	endpoint string
+	mutex    sync.Mutex
+	index    map[string]int
+	snapshot Snapshot
+}
+
+// NewSnapshotRecorder installs a recorder on the HTTP transport of the given
+// client so that all of its system API responses are captured.
+func NewSnapshotRecorder(client *gophercloud.ServiceClient) *SnapshotRecorder {
+	base := client.ProviderClient.HTTPClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := &SnapshotRecorder{
+		base:     base,
+		endpoint: client.Endpoint,
+		index:    make(map[string]int),
+		snapshot: Snapshot{
+			Version:  SnapshotVersion,
+			Created:  time.Now().UTC(),
+			Endpoint: client.Endpoint,
+			Entries:  make([]SnapshotEntry, 0),
+		},
+	}
+
+	client.ProviderClient.HTTPClient.Transport = r
+
+	return r
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *SnapshotRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	url := req.URL.String()
+	if !strings.HasPrefix(url, r.endpoint) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore the body so that it can be consumed by the caller.
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := SnapshotEntry{
+		Method:      req.Method,
+		Path:        strings.TrimPrefix(url, r.endpoint),
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(body),
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := snapshotKey(entry.Method, entry.Path)
+	if i, ok := r.index[key]; ok {
+		r.snapshot.Entries[i] = entry
+	} else {
+		r.index[key] = len(r.snapshot.Entries)
+		r.snapshot.Entries = append(r.snapshot.Entries, entry)
+	}
+
+	return resp, nil
+}
+
+// Snapshot returns the snapshot of all responses recorded so far.
+func (r *SnapshotRecorder) Snapshot() *Snapshot {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	s := r.snapshot
+	s.Entries = append([]SnapshotEntry(nil), r.snapshot.Entries...)
+
+	return &s
+}
+
+// NewSnapshotServer starts a local HTTP server which stands in for the system
+// API by replaying the responses recorded in a snapshot.  Requests that were
+// not recorded are rejected with a 404 response.  The caller is responsible
+// for closing the server.
+func NewSnapshotServer(s *Snapshot) *httptest.Server {
+	entries := make(map[string]SnapshotEntry, len(s.Entries))
+	for _, e := range s.Entries {
+		entries[snapshotKey(e.Method, e.Path)] = e
+	}
+
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		path := strings.TrimPrefix(req.URL.RequestURI(), "/")
+
+		entry, ok := entries[snapshotKey(req.Method, path)]
+		if !ok {
+			msg := fmt.Sprintf("%s %s not found in snapshot", req.Method, path)
+			http.Error(w, msg, http.StatusNotFound)
+			return
+		}
+
+		if entry.ContentType != "" {
+			w.Header().Set("Content-Type", entry.ContentType)
+		}
+
+		w.WriteHeader(entry.StatusCode)
+		_, _ = io.WriteString(w, entry.Body)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(handler))
+}
+
+// NewSnapshotClient creates a system API client which is connected to a
+// snapshot server.
+func NewSnapshotClient(server *httptest.Server) *gophercloud.ServiceClient {
+	url := server.URL + "/"
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			HTTPClient: *server.Client(),
+		},
+		Endpoint:     url,
+		ResourceBase: url,
+	}
+}
@@ -0,0 +1,61 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bytes"
+
+	gcClient "github.com/gophercloud/gophercloud/testhelper/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot utilities", func() {
+	Describe("SnapshotRecorder", func() {
+		Context("when responses are recorded and replayed", func() {
+			It("should build the same deployment from the snapshot", func() {
+				client := gcClient.ServiceClient()
+				recorder := NewSnapshotRecorder(client)
+
+				live := &DeploymentBuilder{client: client, namespace: "fakens"}
+				expected := Deployment{}
+				Expect(live.buildDataNetworks(&expected)).To(Succeed())
+				Expect(live.buildPlatformNetworks(&expected)).To(Succeed())
+				Expect(live.buildPTPInstances(&expected)).To(Succeed())
+				Expect(live.buildPTPInterfaces(&expected)).To(Succeed())
+
+				var buf bytes.Buffer
+				Expect(recorder.Snapshot().Write(&buf)).To(Succeed())
+
+				snapshot, err := ReadSnapshot(&buf)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshot.Version).To(Equal(SnapshotVersion))
+				Expect(snapshot.Entries).ToNot(BeEmpty())
+
+				server := NewSnapshotServer(snapshot)
+				defer server.Close()
+
+				offline := &DeploymentBuilder{client: NewSnapshotClient(server), namespace: "fakens"}
+				got := Deployment{}
+				Expect(offline.buildDataNetworks(&got)).To(Succeed())
+				Expect(offline.buildPlatformNetworks(&got)).To(Succeed())
+				Expect(offline.buildPTPInstances(&got)).To(Succeed())
+				Expect(offline.buildPTPInterfaces(&got)).To(Succeed())
+
+				Expect(got).To(Equal(expected))
+			})
+		})
+
+		Context("when a request was not recorded", func() {
+			It("should fail the request", func() {
+				server := NewSnapshotServer(&Snapshot{Version: SnapshotVersion})
+				defer server.Close()
+
+				offline := &DeploymentBuilder{client: NewSnapshotClient(server), namespace: "fakens"}
+				d := Deployment{}
+				Expect(offline.buildDataNetworks(&d)).ToNot(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,342 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// TemplateValues maps the name of each template placeholder to the value
+// which it stands for.
+type TemplateValues map[string]string
+
+// placeholderRegex matches a string attribute which consists entirely of a
+// template placeholder and captures the placeholder name.
+var placeholderRegex = regexp.MustCompile(`^\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}$`)
+
+// invalidNameRegex matches the characters which cannot be used in a
+// placeholder name.
+var invalidNameRegex = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// Placeholder returns the template placeholder which stands for the value of
+// the given name.
+func Placeholder(name string) string {
+	return fmt.Sprintf("{{ .%s }}", name)
+}
+
+// templatizer replaces site specific attributes with placeholders and records
+// the values which they replaced.
+type templatizer struct {
+	values TemplateValues
+}
+
+// placeholderName builds a unique placeholder name from a list of name
+// components.  Characters which are not allowed in a placeholder name are
+// replaced with underscores.
+func (t *templatizer) placeholderName(parts ...string) string {
+	name := strings.ToLower(invalidNameRegex.ReplaceAllString(strings.Join(parts, "_"), "_"))
+
+	result := name
+	for i := 1; ; i++ {
+		if _, ok := t.values[result]; !ok {
+			return result
+		}
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+}
+
+// replace substitutes a placeholder for a non-empty string attribute.
+func (t *templatizer) replace(value *string, parts ...string) {
+	if value == nil || *value == "" || placeholderRegex.MatchString(*value) {
+		return
+	}
+
+	name := t.placeholderName(parts...)
+	t.values[name] = *value
+	*value = Placeholder(name)
+}
+
+// replaceList substitutes a placeholder for each element of a list of strings.
+func (t *templatizer) replaceList(values []string, parts ...string) {
+	for i := range values {
+		t.replace(&values[i], append(parts, fmt.Sprint(i))...)
+	}
+}
+
+// templatizeProfile substitutes placeholders for the site specific attributes
+// of a host profile, or of the overrides of a host.
+func (t *templatizer) templatizeProfile(spec *starlingxv1.HostProfileSpec, parts ...string) {
+	if spec == nil {
+		return
+	}
+
+	t.replace(spec.Location, append(parts, "location")...)
+
+	if spec.BoardManagement != nil {
+		t.replace(spec.BoardManagement.Address, append(parts, "bm_address")...)
+	}
+
+	for i := range spec.Addresses {
+		t.replace(&spec.Addresses[i].Address, append(parts, "address", fmt.Sprint(i))...)
+	}
+
+	for i := range spec.Routes {
+		r := &spec.Routes[i]
+		t.replace(&r.Network, append(parts, "route", fmt.Sprint(i), "subnet")...)
+		t.replace(&r.Gateway, append(parts, "route", fmt.Sprint(i), "gateway")...)
+	}
+}
+
+// Templatize replaces the site specific attributes of a deployment with named
+// placeholders so that the deployment can be used as a template for other
+// sites.  The attributes replaced are the system location and servers, the
+// address pool subnets, addresses and ranges, the host profile and host
+// override locations, board management addresses, addresses and routes, and
+// the host match attributes.  Numeric attributes, such as prefix lengths, are
+// left unchanged.  The values replaced are returned keyed by placeholder name.
+func Templatize(d *Deployment) TemplateValues {
+	t := templatizer{values: make(TemplateValues)}
+
+	system := &d.System.Spec
+	t.replace(system.Location, "system", "location")
+	t.replace(system.Latitude, "system", "latitude")
+	t.replace(system.Longitude, "system", "longitude")
+	t.replaceList(system.DNSServers, "system", "dns_server")
+	t.replaceList(system.NTPServers, "system", "ntp_server")
+
+	for _, p := range d.AddressPools {
+		prefix := []string{"addresspool", p.Name}
+		t.replace(&p.Spec.Subnet, append(prefix, "subnet")...)
+		t.replace(p.Spec.FloatingAddress, append(prefix, "floating_address")...)
+		t.replace(p.Spec.Controller0Address, append(prefix, "controller0_address")...)
+		t.replace(p.Spec.Controller1Address, append(prefix, "controller1_address")...)
+		t.replace(p.Spec.Gateway, append(prefix, "gateway")...)
+
+		for i := range p.Spec.Allocation.Ranges {
+			r := &p.Spec.Allocation.Ranges[i]
+			t.replace(&r.Start, append(prefix, "range", fmt.Sprint(i), "start")...)
+			t.replace(&r.End, append(prefix, "range", fmt.Sprint(i), "end")...)
+		}
+	}
+
+	for _, p := range d.Profiles {
+		t.templatizeProfile(&p.Spec, "hostprofile", p.Name)
+	}
+
+	for _, h := range d.Hosts {
+		prefix := []string{"host", h.Name}
+
+		if match := h.Spec.Match; match != nil {
+			t.replace(match.BootMAC, append(prefix, "boot_mac")...)
+
+			if match.BoardManagement != nil {
+				t.replace(match.BoardManagement.Address, append(prefix, "match_bm_address")...)
+			}
+
+			if match.DMI != nil {
+				t.replace(match.DMI.SerialNumber, append(prefix, "serial_number")...)
+				t.replace(match.DMI.AssetTag, append(prefix, "asset_tag")...)
+			}
+		}
+
+		t.templatizeProfile(h.Spec.Overrides, prefix...)
+	}
+
+	return t.values
+}
+
+// templateRenderer substitutes values for placeholders and keeps track of the
+// placeholders for which no value was provided.
+type templateRenderer struct {
+	values  TemplateValues
+	missing map[string]bool
+}
+
+// placeholders walks a YAML node tree and returns every value node which
+// consists entirely of a placeholder.  Mapping keys are never substituted.
+func placeholders(node *yamlv3.Node) []*yamlv3.Node {
+	result := make([]*yamlv3.Node, 0)
+
+	switch node.Kind {
+	case yamlv3.DocumentNode, yamlv3.SequenceNode:
+		for _, n := range node.Content {
+			result = append(result, placeholders(n)...)
+		}
+	case yamlv3.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			result = append(result, placeholders(node.Content[i])...)
+		}
+	case yamlv3.ScalarNode:
+		if node.Tag == "!!str" && placeholderRegex.MatchString(node.Value) {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+// scalarText returns the text of a value rendered as a YAML string in the
+// same form as the deployment builder output.  Values which would be
+// rendered as block scalars are double quoted instead so that they fit on
+// the line of the placeholder.
+func scalarText(value string) (string, error) {
+	buf, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	text := strings.TrimSuffix(string(buf), "\n")
+	if !strings.Contains(text, "\n") {
+		return text, nil
+	}
+
+	buf, err = json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+// substitute replaces every placeholder of a document with its value.  The
+// placeholders are located on the YAML node tree and replaced in the text of
+// the document so that comments, key order, and formatting are preserved.
+func (r *templateRenderer) substitute(doc Document) ([]byte, error) {
+	var root yamlv3.Node
+
+	err := yamlv3.Unmarshal(doc.Data, &root)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to parse %s", doc.String())
+		return nil, err
+	}
+
+	lines := strings.Split(string(doc.Data), "\n")
+
+	// Replace the placeholders from last to first so that the columns of
+	// the placeholders which precede them on the same line remain valid.
+	nodes := placeholders(&root)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		name := placeholderRegex.FindStringSubmatch(node.Value)[1]
+
+		value, ok := r.values[name]
+		if !ok {
+			r.missing[name] = true
+			continue
+		}
+
+		token := node.Value
+		switch node.Style {
+		case yamlv3.SingleQuotedStyle:
+			token = "'" + token + "'"
+		case yamlv3.DoubleQuotedStyle:
+			token = "\"" + token + "\""
+		}
+
+		line := []rune(lines[node.Line-1])
+		column := node.Column - 1
+		if column+len([]rune(token)) > len(line) || string(line[column:column+len([]rune(token))]) != token {
+			return nil, perrors.Errorf("failed to locate placeholder %q of %s at line %d",
+				name, doc.String(), doc.Line+node.Line-1)
+		}
+
+		text, err := scalarText(value)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to render value of placeholder %q", name)
+			return nil, err
+		}
+
+		lines[node.Line-1] = string(line[:column]) + text + string(line[column+len([]rune(token)):])
+	}
+
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+// RenderTemplate substitutes the provided values for the placeholders of a
+// deployment template produced by Templatize.  Each document is otherwise
+// written as it appears in the template, including its comments.  An error
+// listing every placeholder without a value is returned if any values are
+// missing.
+func RenderTemplate(data []byte, values TemplateValues) ([]byte, error) {
+	docs, err := SplitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	r := templateRenderer{values: values, missing: make(map[string]bool)}
+
+	var b bytes.Buffer
+
+	b.Write([]byte(yamlSeparator))
+
+	for _, doc := range docs {
+		buf, err := r.substitute(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(buf)
+		b.Write([]byte(yamlSeparator))
+	}
+
+	if len(r.missing) > 0 {
+		names := make([]string, 0, len(r.missing))
+		for name := range r.missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, perrors.Errorf("no value provided for placeholder(s): %s",
+			strings.Join(names, ", "))
+	}
+
+	return b.Bytes(), nil
+}
+
+// ParseTemplateValues decodes a values file produced alongside a deployment
+// template.  Numeric and boolean values are accepted and converted to their
+// string form since YAML does not require addresses or coordinates to be
+// quoted.
+func ParseTemplateValues(data []byte) (TemplateValues, error) {
+	buf, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to parse template values")
+		return nil, err
+	}
+
+	raw := make(map[string]interface{})
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+
+	err = decoder.Decode(&raw)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to decode template values")
+		return nil, err
+	}
+
+	values := make(TemplateValues, len(raw))
+	for name, value := range raw {
+		switch v := value.(type) {
+		case string:
+			values[name] = v
+		case json.Number, bool:
+			values[name] = fmt.Sprint(v)
+		default:
+			return nil, perrors.Errorf("template value %q must be a scalar", name)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,220 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Template utilities", func() {
+	newDeployment := func() *Deployment {
+		str := func(s string) *string { return &s }
+		meta := func(name string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{Name: name, Namespace: "fakens"}
+		}
+		typeMeta := func(kind string) metav1.TypeMeta {
+			return metav1.TypeMeta{APIVersion: starlingxv1.APIVersion, Kind: kind}
+		}
+
+		return &Deployment{
+			Namespace: v1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: KindNamespace},
+				ObjectMeta: metav1.ObjectMeta{Name: "fakens"},
+			},
+			System: starlingxv1.System{
+				TypeMeta:   typeMeta(starlingxv1.KindSystem),
+				ObjectMeta: meta("vbox"),
+				Spec: starlingxv1.SystemSpec{
+					Location:   str("St. John's"),
+					DNSServers: starlingxv1.DNSServerList{"8.8.8.8"},
+				},
+			},
+			AddressPools: []*starlingxv1.AddressPool{{
+				TypeMeta:   typeMeta(starlingxv1.KindAddressPool),
+				ObjectMeta: meta("oam-ipv4"),
+				Spec: starlingxv1.AddressPoolSpec{
+					Subnet:          "10.10.10.0",
+					Prefix:          24,
+					FloatingAddress: str("10.10.10.2"),
+					Allocation: starlingxv1.AllocationInfo{
+						Ranges: []starlingxv1.AllocationRange{{Start: "10.10.10.1", End: "10.10.10.254"}},
+					},
+				},
+			}},
+			Profiles: []*starlingxv1.HostProfile{{
+				TypeMeta:   typeMeta(starlingxv1.KindHostProfile),
+				ObjectMeta: meta("controller-0-profile"),
+				Spec: starlingxv1.HostProfileSpec{
+					BoardManagement: &starlingxv1.BMInfo{Type: str("dynamic"), Address: str("192.168.1.10")},
+					Routes: starlingxv1.RouteList{{
+						Interface: "oam0", Network: "0.0.0.0", Prefix: 0, Gateway: "10.10.10.1",
+					}},
+				},
+			}},
+			Hosts: []*starlingxv1.Host{{
+				TypeMeta:   typeMeta(starlingxv1.KindHost),
+				ObjectMeta: meta("controller-0"),
+				Spec: starlingxv1.HostSpec{
+					Profile: "controller-0-profile",
+					Match: &starlingxv1.MatchInfo{
+						BootMAC: str("08:00:27:aa:bb:cc"),
+						DMI:     &starlingxv1.MatchDMIInfo{SerialNumber: str("SN-1234")},
+					},
+					Overrides: &starlingxv1.HostProfileSpec{
+						ProfileBaseAttributes: starlingxv1.ProfileBaseAttributes{Location: str("rack 1")},
+					},
+				},
+			}},
+		}
+	}
+
+	Describe("Templatize", func() {
+		Context("when a deployment contains site specific values", func() {
+			It("should replace them with placeholders", func() {
+				d := newDeployment()
+				values := Templatize(d)
+
+				Expect(values).To(Equal(TemplateValues{
+					"system_location":                                  "St. John's",
+					"system_dns_server_0":                              "8.8.8.8",
+					"addresspool_oam_ipv4_subnet":                      "10.10.10.0",
+					"addresspool_oam_ipv4_floating_address":            "10.10.10.2",
+					"addresspool_oam_ipv4_range_0_start":               "10.10.10.1",
+					"addresspool_oam_ipv4_range_0_end":                 "10.10.10.254",
+					"hostprofile_controller_0_profile_bm_address":      "192.168.1.10",
+					"hostprofile_controller_0_profile_route_0_subnet":  "0.0.0.0",
+					"hostprofile_controller_0_profile_route_0_gateway": "10.10.10.1",
+					"host_controller_0_boot_mac":                       "08:00:27:aa:bb:cc",
+					"host_controller_0_serial_number":                  "SN-1234",
+					"host_controller_0_location":                       "rack 1",
+				}))
+
+				Expect(d.AddressPools[0].Spec.Subnet).To(Equal("{{ .addresspool_oam_ipv4_subnet }}"))
+				Expect(d.AddressPools[0].Spec.Prefix).To(Equal(24))
+				Expect(*d.Profiles[0].Spec.BoardManagement.Type).To(Equal("dynamic"))
+			})
+		})
+	})
+
+	Describe("RenderTemplate", func() {
+		Context("when all values are provided", func() {
+			It("should reproduce the original deployment", func() {
+				original, err := newDeployment().ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				d := newDeployment()
+				values := Templatize(d)
+				template, err := d.ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				rendered, err := RenderTemplate([]byte(template), values)
+				Expect(err).ToNot(HaveOccurred())
+
+				expected, err := ParseDeployment([]byte(original))
+				Expect(err).ToNot(HaveOccurred())
+				got, err := ParseDeployment(rendered)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got).To(Equal(expected))
+			})
+		})
+
+		Context("when the template has comments", func() {
+			It("should reproduce the original text along with the comments", func() {
+				comment := func(text string) string {
+					text = strings.ReplaceAll(text, "---\napiVersion:", "---\n# Reviewed by the site owner\napiVersion:")
+					return strings.ReplaceAll(text, "kind: System\n", "kind: System # one per site\n")
+				}
+
+				original, err := newDeployment().ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				d := newDeployment()
+				values := Templatize(d)
+				template, err := d.ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				rendered, err := RenderTemplate([]byte(comment(template)), values)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rendered)).To(ContainSubstring("kind: System # one per site\n"))
+				Expect(string(rendered)).To(Equal(comment(original)))
+			})
+		})
+
+		Context("when the template keys are not sorted", func() {
+			It("should keep the order of the keys", func() {
+				template := `---
+kind: System
+apiVersion: starlingx.windriver.com/v1
+metadata:
+  name: vbox
+  namespace: fakens
+spec:
+  # Coordinates of the site
+  latitude: '{{ .system_latitude }}'
+  location: "{{ .system_location }}"
+  dnsServers:
+  - '{{ .system_dns_server_0 }}' # primary
+  - 8.8.8.8
+`
+				values := TemplateValues{
+					"system_latitude":     "45.5",
+					"system_location":     "Ottawa",
+					"system_dns_server_0": "10.10.10.1",
+				}
+
+				rendered, err := RenderTemplate([]byte(template), values)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rendered)).To(Equal(`---
+kind: System
+apiVersion: starlingx.windriver.com/v1
+metadata:
+  name: vbox
+  namespace: fakens
+spec:
+  # Coordinates of the site
+  latitude: "45.5"
+  location: Ottawa
+  dnsServers:
+  - 10.10.10.1 # primary
+  - 8.8.8.8
+---
+`))
+			})
+		})
+
+		Context("when values are missing", func() {
+			It("should list the missing placeholders", func() {
+				d := newDeployment()
+				values := Templatize(d)
+				template, err := d.ToYAML()
+				Expect(err).ToNot(HaveOccurred())
+
+				delete(values, "host_controller_0_boot_mac")
+				delete(values, "system_location")
+
+				_, err = RenderTemplate([]byte(template), values)
+				Expect(err).To(MatchError(ContainSubstring("host_controller_0_boot_mac, system_location")))
+			})
+		})
+	})
+
+	Describe("ParseTemplateValues", func() {
+		It("should accept unquoted scalar values", func() {
+			values, err := ParseTemplateValues([]byte("system_latitude: 45.5\nsystem_location: Ottawa\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal(TemplateValues{"system_latitude": "45.5", "system_location": "Ottawa"}))
+		})
+
+		It("should reject non-scalar values", func() {
+			_, err := ParseTemplateValues([]byte("system_location:\n  - Ottawa\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,262 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	"fmt"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+// bootstrapNetworks is the set of platform networks that are created on the
+// system during bootstrap.  Interfaces may refer to these networks without a
+// matching PlatformNetwork resource being defined in the deployment
+// configuration.
+var bootstrapNetworks = map[string]bool{
+	pxebootNetwork:          true,
+	mgmtNetwork:             true,
+	clusterNetwork:          true,
+	oamNetwork:              true,
+	adminNetwork:            true,
+	"cluster-pod":           true,
+	"cluster-service":       true,
+	"multicast":             true,
+	"system-controller":     true,
+	"system-controller-oam": true,
+}
+
+// DocumentError associates a validation error with the location of the
+// document in which it was found.
+type DocumentError struct {
+	// Line is the 1-based line number at which the error was found.
+	Line int
+
+	// Kind is the kind of the resource in which the error was found.
+	Kind string
+
+	// Name is the name of the resource in which the error was found.
+	Name string
+
+	// Err is the underlying validation error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e DocumentError) Error() string {
+	return fmt.Sprintf("line %d: %s/%s: %s", e.Line, e.Kind, e.Name, e.Err.Error())
+}
+
+// newDocumentError creates a DocumentError for the given document.
+func newDocumentError(doc Document, line int, format string, args ...interface{}) DocumentError {
+	return DocumentError{
+		Line: line,
+		Kind: doc.Kind,
+		Name: doc.Name,
+		Err:  perrors.Errorf(format, args...),
+	}
+}
+
+// unquote removes the surrounding quotes from a scalar YAML value.
+func unquote(value string) string {
+	return strings.Trim(strings.TrimSpace(value), `"'`)
+}
+
+// LineOf returns the line number at which the named attribute is set to the
+// given value, either directly or as an element of a list.  If the attribute
+// cannot be located then the line at which the document starts is returned.
+func (d Document) LineOf(key, value string) int {
+	lines := strings.Split(string(d.Data), "\n")
+	inList := false
+
+	for i, l := range lines {
+		trimmed := strings.TrimSpace(l)
+
+		if strings.HasPrefix(trimmed, key+":") {
+			rest := strings.TrimSpace(strings.TrimPrefix(trimmed, key+":"))
+			if strings.HasPrefix(rest, "[") {
+				for _, item := range strings.Split(strings.Trim(rest, "[]"), ",") {
+					if unquote(item) == value {
+						return d.Line + i
+					}
+				}
+			} else if unquote(rest) == value {
+				return d.Line + i
+			}
+
+			inList = rest == ""
+			continue
+		}
+
+		if inList {
+			if strings.HasPrefix(trimmed, "- ") {
+				if unquote(strings.TrimPrefix(trimmed, "- ")) == value {
+					return d.Line + i
+				}
+			} else if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+				inList = false
+			}
+		}
+	}
+
+	return d.Line
+}
+
+// documentIndex tracks the names of the resources defined in a deployment
+// configuration by kind and namespace.
+type documentIndex map[string]map[string]bool
+
+func (i documentIndex) key(kind, namespace string) string {
+	return kind + "/" + namespace
+}
+
+func (i documentIndex) add(kind, namespace, name string) bool {
+	key := i.key(kind, namespace)
+	if _, ok := i[key]; !ok {
+		i[key] = make(map[string]bool)
+	}
+
+	if i[key][name] {
+		return false
+	}
+
+	i[key][name] = true
+	return true
+}
+
+func (i documentIndex) contains(kind, namespace, name string) bool {
+	return i[i.key(kind, namespace)][name]
+}
+
+// referenceChecker accumulates the errors found while verifying the
+// references between the documents of a deployment configuration.
+type referenceChecker struct {
+	index  documentIndex
+	errors []DocumentError
+}
+
+// check verifies that a resource of the given kind and name is defined in
+// the same namespace as the referring document.
+func (c *referenceChecker) check(doc Document, key, kind, name string) {
+	if name == "" || c.index.contains(kind, doc.Namespace, name) {
+		return
+	}
+
+	c.errors = append(c.errors, newDocumentError(doc, doc.LineOf(key, name),
+		"%s %q referenced by %q is not defined", kind, name, key))
+}
+
+// checkInterface verifies the references of a single interface.
+func (c *referenceChecker) checkInterface(doc Document, info *starlingxv1.CommonInterfaceInfo) {
+	for _, name := range info.PlatformNetworks {
+		if !bootstrapNetworks[name] {
+			c.check(doc, "platformNetworks", starlingxv1.KindPlatformNetwork, name)
+		}
+	}
+
+	for _, name := range info.DataNetworks {
+		c.check(doc, "dataNetworks", starlingxv1.KindDataNetwork, name)
+	}
+
+	for _, name := range info.PtpInterfaces {
+		c.check(doc, "ptpInterfaces", starlingxv1.KindPTPInterface, name)
+	}
+}
+
+// checkProfile verifies the references of a profile spec which is either
+// defined by a HostProfile or as the overrides of a Host.
+func (c *referenceChecker) checkProfile(doc Document, spec *starlingxv1.HostProfileSpec) {
+	if spec.Base != nil {
+		c.check(doc, "base", starlingxv1.KindHostProfile, *spec.Base)
+	}
+
+	for _, name := range spec.PtpInstances {
+		c.check(doc, "ptpInstances", starlingxv1.KindPTPInstance, name)
+	}
+
+	if spec.Interfaces == nil {
+		return
+	}
+
+	for i := range spec.Interfaces.Ethernet {
+		c.checkInterface(doc, &spec.Interfaces.Ethernet[i].CommonInterfaceInfo)
+	}
+
+	for i := range spec.Interfaces.VLAN {
+		c.checkInterface(doc, &spec.Interfaces.VLAN[i].CommonInterfaceInfo)
+	}
+
+	for i := range spec.Interfaces.Bond {
+		c.checkInterface(doc, &spec.Interfaces.Bond[i].CommonInterfaceInfo)
+	}
+
+	for i := range spec.Interfaces.VF {
+		c.checkInterface(doc, &spec.Interfaces.VF[i].CommonInterfaceInfo)
+	}
+}
+
+// checkDocument verifies the references of a single decoded document.
+func (c *referenceChecker) checkDocument(doc Document, obj interface{}) {
+	switch o := obj.(type) {
+	case *starlingxv1.System:
+		for _, cert := range o.Spec.Certificates {
+			// Certificates installed during bootstrap are not validated by
+			// the admission webhook and do not require a secret.
+			if cert.Type == starlingxv1.OpenstackCACertificate || cert.Type == starlingxv1.OpenLDAPCertificate ||
+				cert.Type == starlingxv1.DockerCertificate || cert.Type == starlingxv1.PlatformCertificate {
+				continue
+			}
+
+			c.check(doc, "secret", KindSecret, cert.Secret)
+		}
+
+	case *starlingxv1.PlatformNetwork:
+		for _, name := range o.Spec.AssociatedAddressPools {
+			c.check(doc, "associatedAddressPools", starlingxv1.KindAddressPool, name)
+		}
+
+	case *starlingxv1.PtpInterface:
+		c.check(doc, "ptpinstance", starlingxv1.KindPTPInstance, o.Spec.PtpInstance)
+
+	case *starlingxv1.HostProfile:
+		c.checkProfile(doc, &o.Spec)
+
+	case *starlingxv1.Host:
+		c.check(doc, "profile", starlingxv1.KindHostProfile, o.Spec.Profile)
+		if o.Spec.Overrides != nil {
+			c.checkProfile(doc, o.Spec.Overrides)
+		}
+	}
+}
+
+// ValidateReferences verifies that every resource referenced by name from
+// within a deployment configuration is defined by another document in the
+// same namespace, and that no resource is defined more than once.  Documents
+// which cannot be decoded are skipped; it is expected that decoding errors
+// are reported separately.
+func ValidateReferences(docs []Document) []DocumentError {
+	c := referenceChecker{
+		index:  make(documentIndex),
+		errors: make([]DocumentError, 0),
+	}
+
+	objects := make([]interface{}, len(docs))
+	for i, doc := range docs {
+		if !c.index.add(doc.Kind, doc.Namespace, doc.Name) {
+			c.errors = append(c.errors, newDocumentError(doc, doc.Line,
+				"%s %q is defined more than once", doc.Kind, doc.Name))
+		}
+
+		objects[i], _ = DecodeDocument(doc)
+	}
+
+	for i, doc := range docs {
+		if objects[i] != nil {
+			c.checkDocument(doc, objects[i])
+		}
+	}
+
+	return c.errors
+}
@@ -0,0 +1,86 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package build
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const validateInput = `---
+apiVersion: starlingx.windriver.com/v1
+kind: HostProfile
+metadata:
+  name: base-profile
+  namespace: deployment
+spec: {}
+---
+apiVersion: starlingx.windriver.com/v1
+kind: HostProfile
+metadata:
+  name: worker-profile
+  namespace: deployment
+spec:
+  base: base-profile
+  interfaces:
+    ethernet:
+    - name: data0
+      class: data
+      platformNetworks:
+      - mgmt
+      - data0v4
+      dataNetworks: [group0-data0]
+      port:
+        name: eth1
+---
+apiVersion: starlingx.windriver.com/v1
+kind: Host
+metadata:
+  name: worker-0
+  namespace: deployment
+spec:
+  profile: missing-profile
+---
+apiVersion: starlingx.windriver.com/v1
+kind: Host
+metadata:
+  name: worker-0
+  namespace: deployment
+spec:
+  profile: worker-profile
+`
+
+var _ = Describe("Validate utilities", func() {
+	Describe("ValidateReferences", func() {
+		Context("when resources reference undefined resources", func() {
+			It("should report each reference with its line number", func() {
+				docs, err := SplitDocuments([]byte(validateInput))
+				Expect(err).ToNot(HaveOccurred())
+
+				errs := ValidateReferences(docs)
+				messages := make([]string, 0)
+				for _, e := range errs {
+					messages = append(messages, e.Error())
+				}
+
+				Expect(messages).To(ConsistOf(
+					`line 35: Host/worker-0: Host "worker-0" is defined more than once`,
+					`line 22: HostProfile/worker-profile: PlatformNetwork "data0v4" referenced by "platformNetworks" is not defined`,
+					`line 23: HostProfile/worker-profile: DataNetwork "group0-data0" referenced by "dataNetworks" is not defined`,
+					`line 33: Host/worker-0: HostProfile "missing-profile" referenced by "profile" is not defined`,
+				))
+			})
+		})
+	})
+
+	Describe("LineOf", func() {
+		Context("when the attribute cannot be found", func() {
+			It("should return the start of the document", func() {
+				doc := Document{Line: 10, Data: []byte("kind: Host\nspec:\n  profile: foo\n")}
+				Expect(doc.LineOf("profile", "foo")).To(Equal(12))
+				Expect(doc.LineOf("profile", "bar")).To(Equal(10))
+			})
+		})
+	})
+})
@@ -0,0 +1,234 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+)
+
+const (
+	EndpointsFileArg = "endpoints-file"
+	OutputDirArg     = "output-dir"
+	ParallelArg      = "parallel"
+)
+
+// newEndpointClient returns a client for the system described by a batch
+// endpoint.  The returned function releases the client once it is no longer
+// needed.
+func newEndpointClient(endpoint build.BatchEndpoint) (*gophercloud.ServiceClient, func(), error) {
+	if endpoint.Snapshot != "" {
+		return openSnapshotClient(endpoint.Snapshot)
+	}
+
+	lookup, err := endpoint.CredentialLookup(os.Getenv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := connectPlatformClient(lookup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, func() {}, nil
+}
+
+// buildEndpoint extracts the configuration of a single batch endpoint and
+// writes it to the output directory.  The progress of the build is written
+// to a log file alongside the output so that the output of concurrent
+// builds is not interleaved.
+func buildEndpoint(endpoint build.BatchEndpoint, options *builderOptions, outputDir string) (result build.BatchResult) {
+	result = build.BatchResult{
+		Name:      endpoint.Name,
+		Namespace: endpoint.Namespace,
+		Output:    filepath.Join(outputDir, endpoint.Name+".yaml"),
+	}
+
+	logFile, err := os.Create(filepath.Join(outputDir, endpoint.Name+".log"))
+	if err != nil {
+		result.Err = perrors.Wrap(err, "failed to create log file")
+		return result
+	}
+
+	defer func() { _ = logFile.Close() }()
+
+	client, closeClient, err := newEndpointClient(endpoint)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	defer closeClient()
+
+	builder := build.NewDeploymentBuilder(client, endpoint.Namespace, endpoint.Name, logFile)
+
+	configureBuilder(builder, options)
+
+	deployment, err := builder.Build()
+	if err != nil {
+		result.Err = perrors.Wrap(err, "failed to build deployment details")
+		return result
+	}
+
+	result.IncompleteSecrets = len(deployment.IncompleteSecrets)
+
+	buf, err := deployment.ToYAML()
+	if err != nil {
+		result.Err = perrors.Wrap(err, "failed to convert deployment struct to YAML")
+		return result
+	}
+
+	source := endpoint.Snapshot
+	if source == "" {
+		source = endpoint.AuthURL
+	}
+
+	header := fmt.Sprintf("# Generated: %s\n# Source: %s\n# Tool version: %s\n",
+		time.Now().Format(time.UnixDate),
+		source,
+		VersionToString())
+
+	err = os.WriteFile(result.Output, append([]byte(header), buf...), 0600)
+	if err != nil {
+		result.Err = perrors.Wrap(err, "failed to write to output file")
+		return result
+	}
+
+	_, _ = fmt.Fprintf(logFile, "done.\n")
+
+	return result
+}
+
+func BatchBuildCmdRun(cmd *cobra.Command, args []string) {
+	filename, err := cmd.Flags().GetString(EndpointsFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			EndpointsFileArg)
+		os.Exit(2)
+	} else if filename == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "endpoints file name must not be blank\n")
+		os.Exit(3)
+	}
+
+	outputDir, err := cmd.Flags().GetString(OutputDirArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputDirArg)
+		os.Exit(2)
+	}
+
+	parallel, err := cmd.Flags().GetInt(ParallelArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			ParallelArg)
+		os.Exit(2)
+	} else if parallel < 1 {
+		_, _ = fmt.Fprintf(os.Stderr, "the number of parallel builds must be at least 1\n")
+		os.Exit(3)
+	}
+
+	namespace, err := cmd.Flags().GetString(NamespaceNameArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			NamespaceNameArg)
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read endpoints file: %s\n", err.Error())
+		os.Exit(4)
+	}
+
+	config, err := build.ParseBatchConfig(data, namespace)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", filename, err.Error())
+		os.Exit(5)
+	}
+
+	options := getBuilderOptions(cmd)
+
+	// Check the filter configuration once up front rather than from within
+	// each of the concurrent builds.
+	configureBuilder(build.NewDeploymentBuilder(nil, namespace, "", io.Discard), options)
+
+	err = os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to create output directory: %s\n", err.Error())
+		os.Exit(6)
+	}
+
+	fmt.Printf("building %d system(s) with up to %d in parallel...\n", len(config.Endpoints), parallel)
+
+	results := build.RunBatch(config.Endpoints, parallel, func(e build.BatchEndpoint) build.BatchResult {
+		return buildEndpoint(e, options, outputDir)
+	})
+
+	fmt.Println()
+	_ = build.WriteBatchSummary(os.Stdout, results)
+
+	incomplete := false
+	for _, r := range results {
+		if r.Err != nil {
+			os.Exit(1)
+		} else if r.IncompleteSecrets > 0 {
+			incomplete = true
+		}
+	}
+
+	if incomplete {
+		fmt.Printf("\nWarning: Some of the generated deployment configurations contain\n")
+		fmt.Printf("  kubernetes Secrets that must be manually edited to add information\n")
+		fmt.Printf("  that is not retrievable from the system.\n")
+		os.Exit(44)
+	}
+}
+
+// batchBuildCmd represents the batch-build command
+var batchBuildCmd = &cobra.Command{
+	Use:   "batch-build",
+	Short: "The batch-build subcommand extracts the configuration of many systems",
+	Long: `The batch-build subcommand extracts the configuration of each of the systems
+listed in an endpoints file with a bounded number of builds running in
+parallel.  Each system is written to its own file in the output directory along
+with a log of its progress, and a summary of the outcome of each build is
+printed once all of them have finished.  For example:
+
+  endpoints:
+  - name: subcloud1
+    namespace: subcloud1
+    authURL: https://10.10.10.2:5000/v3
+    region: subcloud1
+    credentials: subcloud1-openrc
+    passwordEnv: SUBCLOUD1_PASSWORD
+  - name: subcloud2
+    snapshot: subcloud2.json
+
+Credentials are resolved from the endpoint attributes, then from the openrc
+style credentials file, and finally from the current environment variables.
+
+The command exits with 0 if all systems were built, 1 if any of the builds
+failed, 44 if any of the outputs contain incomplete secrets, and any other
+value if the batch could not be started.`,
+	Run: BatchBuildCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(batchBuildCmd)
+
+	batchBuildCmd.Flags().StringP(EndpointsFileArg, "e", "", "The file listing the systems to be extracted")
+	batchBuildCmd.Flags().StringP(OutputDirArg, "d", ".", "The directory used to store the output of each system")
+	batchBuildCmd.Flags().IntP(ParallelArg, "p", 4, "The maximum number of systems extracted in parallel")
+	batchBuildCmd.Flags().StringP(NamespaceNameArg, "n", "deployment", "The namespace used for systems which do not specify one")
+	addBuilderOptionFlags(batchBuildCmd)
+}
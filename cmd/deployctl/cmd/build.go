@@ -4,12 +4,17 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	neturl "net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/spf13/cobra"
@@ -21,51 +26,63 @@ const (
 	OutputFileNameArg                = "output-file"
 	SystemNameArg                    = "system-name"
 	NamespaceNameArg                 = "namespace-name"
-	NoCACertificatesFilterArg        = "no-ca-certificates"
-	NoDefaultsFilterArg              = "no-defaults"
-	NoMemoryFilterArg                = "no-memory"
-	NoProcessorFilterArg             = "no-processors"
-	NoInterfaceDefaultsFilterArg     = "no-interface-defaults"
-	NoServiceParametersFilterArg     = "no-service-parameters"
-	NoSysVgFilterArg                 = "no-sys-vg"
-	NormalizeInterfaceNamesFilterArg = "normalize-interfaces"
-	NormalizeInterfaceMTUFilterArg   = "normalize-mtu"
-	NormalizeConsoleFilterArg        = "normalize-console"
-	MinimalConfigFilterArg           = "minimal-config"
+	NoCACertificatesFilterArg        = build.NoCACertificatesPreset
+	NoDefaultsFilterArg              = build.NoDefaultsPreset
+	NoMemoryFilterArg                = build.NoMemoryPreset
+	NoProcessorFilterArg             = build.NoProcessorsPreset
+	NoInterfaceDefaultsFilterArg     = build.NoInterfaceDefaultsPreset
+	NoServiceParametersFilterArg     = build.NoServiceParametersPreset
+	NoSysVgFilterArg                 = build.NoSysVgPreset
+	NormalizeInterfaceNamesFilterArg = build.NormalizeInterfaceNamesPreset
+	NormalizeInterfaceMTUFilterArg   = build.NormalizeInterfaceMTUPreset
+	NormalizeConsoleFilterArg        = build.NormalizeConsolePreset
+	MinimalConfigFilterArg           = build.MinimalConfigPreset
+	FromSnapshotArg                  = "from-snapshot"
+	FactorProfilesArg                = "factor-profiles"
+	FiltersConfigArg                 = "filters-config"
+	OutputFormatArg                  = "output-format"
+	TemplatizeArg                    = "templatize"
+	ValuesFileArg                    = "values-file"
+	SecretsFromArg                   = "secrets-from"
+	SecretsReportArg                 = "secrets-report"
+	ReportArg                        = "report"
 )
 
-func CollectCmdRun(cmd *cobra.Command, args []string) {
-	var normalizeInterfaces bool
-	var noInterfaceDefaults bool
-	var normalizeConsole bool
-	var noCACertificates bool
-	var noDRBDLinkUtilization bool
-	var noCorePlatformNetworks bool
-	var noFileSystems bool
-	var noServiceParams bool
-	var outputFile *os.File
-	var minimalConfig bool
-	var noProcessors bool
-	var normalizeMTU bool
-	var namespace string
-	var noDefaults bool
-	var noMemory bool
-	var noSysVg bool
-	var name string
-	var err error
+// Supported output formats.
+const (
+	YAMLOutputFormat      = "yaml"
+	KustomizeOutputFormat = "kustomize"
+)
 
-	if outputFilename, err := cmd.Flags().GetString(OutputFileNameArg); err == nil {
-		outputFile, err = os.Create(outputFilename)
-		if err != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "failed to open output file: %s\n",
-				err.Error())
-			os.Exit(1)
-		}
-	} else {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			OutputFileNameArg)
-		os.Exit(2)
-	}
+// defaultOutputFileName is the output path used when none is specified.  The
+// kustomize output format uses the same path without the file extension as
+// the name of its output directory.
+const defaultOutputFileName = "deployment-config.yaml"
+
+// filterPresetArgs lists the filter shorthand arguments, each of which
+// enables the filter preset of the same name, along with the exit code used
+// if the argument cannot be retrieved.
+var filterPresetArgs = []struct {
+	name     string
+	exitCode int
+}{
+	{NoMemoryFilterArg, 6},
+	{NoProcessorFilterArg, 7},
+	{NoInterfaceDefaultsFilterArg, 8},
+	{NoDefaultsFilterArg, 9},
+	{NormalizeInterfaceNamesFilterArg, 10},
+	{NormalizeInterfaceMTUFilterArg, 11},
+	{NormalizeConsoleFilterArg, 12},
+	{MinimalConfigFilterArg, 13},
+	{NoCACertificatesFilterArg, 14},
+	{NoSysVgFilterArg, 15},
+	{NoServiceParametersFilterArg, 16},
+}
+
+// getBuilderNames retrieves and validates the namespace and system names
+// from the command line arguments.
+func getBuilderNames(cmd *cobra.Command) (namespace string, name string) {
+	var err error
 
 	if namespace, err = cmd.Flags().GetString(NamespaceNameArg); err == nil {
 		if namespace == "" {
@@ -103,95 +120,72 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		os.Exit(5)
 	}
 
-	if noMemory, err = cmd.Flags().GetBool(NoMemoryFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoMemoryFilterArg)
-		os.Exit(6)
-	}
-
-	if noProcessors, err = cmd.Flags().GetBool(NoProcessorFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoProcessorFilterArg)
-		os.Exit(7)
-	}
-
-	if noInterfaceDefaults, err = cmd.Flags().GetBool(NoInterfaceDefaultsFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoInterfaceDefaultsFilterArg)
-		os.Exit(8)
-	}
-
-	if noDefaults, err = cmd.Flags().GetBool(NoDefaultsFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoDefaultsFilterArg)
-		os.Exit(9)
-	}
+	return namespace, name
+}
 
-	if normalizeInterfaces, err = cmd.Flags().GetBool(NormalizeInterfaceNamesFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NormalizeInterfaceNamesFilterArg)
-		os.Exit(10)
-	}
+// getFilterConfig retrieves the filter selections from the command line
+// arguments.  The filters listed in the filter configuration file, if one was
+// specified, are combined with the presets selected by the filter shorthand
+// arguments.
+func getFilterConfig(cmd *cobra.Command) *build.FilterConfig {
+	config := &build.FilterConfig{}
 
-	if normalizeMTU, err = cmd.Flags().GetBool(NormalizeInterfaceMTUFilterArg); err != nil {
+	filename, err := cmd.Flags().GetString(FiltersConfigArg)
+	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NormalizeInterfaceMTUFilterArg)
-		os.Exit(11)
+			FiltersConfigArg)
+		os.Exit(18)
 	}
 
-	if normalizeConsole, err = cmd.Flags().GetBool(NormalizeConsoleFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NormalizeConsoleFilterArg)
-		os.Exit(12)
-	}
+	if filename != "" {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to read filter configuration file: %s\n", err.Error())
+			os.Exit(19)
+		}
 
-	if minimalConfig, err = cmd.Flags().GetBool(MinimalConfigFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NormalizeConsoleFilterArg)
-		os.Exit(13)
+		config, err = build.ParseFilterConfig(data)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", filename, err.Error())
+			os.Exit(20)
+		}
 	}
 
-	if noCACertificates, err = cmd.Flags().GetBool(NoCACertificatesFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoCACertificatesFilterArg)
-		os.Exit(14)
-	}
+	for _, arg := range filterPresetArgs {
+		enabled, err := cmd.Flags().GetBool(arg.name)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+				arg.name)
+			os.Exit(arg.exitCode)
+		}
 
-	if noSysVg, err = cmd.Flags().GetBool(NoSysVgFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoSysVgFilterArg)
-		os.Exit(15)
+		if enabled {
+			config.Presets = append(config.Presets, arg.name)
+		}
 	}
 
-	if noServiceParams, err = cmd.Flags().GetBool(NoServiceParametersFilterArg); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
-			NoServiceParametersFilterArg)
-		os.Exit(16)
-	}
+	return config
+}
 
-	if minimalConfig {
-		noCACertificates = true
-		noDefaults = true
-		noDRBDLinkUtilization = true
-		noCorePlatformNetworks = true
-		noFileSystems = true
-		noInterfaceDefaults = true
-		normalizeInterfaces = true
-		normalizeMTU = true
-		normalizeConsole = true
-		noServiceParams = true
-	}
+// platformClientError records why a client for the system API could not be
+// created along with the exit code used by the build subcommand.
+type platformClientError struct {
+	exitCode int
+	message  string
+}
 
-	if noDefaults {
-		noDRBDLinkUtilization = true
-		noFileSystems = true
-		noCACertificates = true
-	}
+func (in *platformClientError) Error() string {
+	return in.message
+}
 
-	ao, err := manager.GetAuthOptionsFromEnv()
+// connectPlatformClient authenticates with keystone and returns a client for
+// the system API.  The OS_* variables which describe the system are resolved
+// with the lookup function.
+func connectPlatformClient(lookup func(string) string) (*gophercloud.ServiceClient, error) {
+	ao, err := manager.GetAuthOptionsFromLookup(lookup)
 	if err != nil {
-		_, _ = fmt.Fprintln(os.Stderr, "failed to build authentication options", err)
-		os.Exit(30)
+		return nil, &platformClientError{30,
+			fmt.Sprintf("failed to build authentication options %s", err.Error())}
 	}
 
 	ao.AllowReauth = true
@@ -199,28 +193,28 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 	// Authenticate with keystone to make a new client.
 	provider, err := openstack.AuthenticatedClient(ao)
 	if err != nil {
+		var msg string
 		if urlError, ok := err.(*neturl.Error); ok {
 			if urlError.Err.Error() == "EOF" && strings.Contains(ao.IdentityEndpoint, manager.HTTPPrefix) {
-				_, _ = fmt.Fprintf(os.Stderr, "URL contains an HTTP scheme but the server might be HTTPS enabled; %s\n",
+				msg = fmt.Sprintf("URL contains an HTTP scheme but the server might be HTTPS enabled; %s",
 					ao.IdentityEndpoint)
 			} else if strings.Contains(err.Error(), manager.HTTPSNotEnabled) && strings.Contains(ao.IdentityEndpoint, manager.HTTPSPrefix) {
-				_, _ = fmt.Fprintf(os.Stderr, "URL contains an HTTPS scheme but the server is not HTTPS enabled; %s\n",
+				msg = fmt.Sprintf("URL contains an HTTPS scheme but the server is not HTTPS enabled; %s",
 					ao.IdentityEndpoint)
 			} else {
-				_, _ = fmt.Fprintf(os.Stderr, "unknown URL error: %s\n",
-					urlError.Error())
+				msg = fmt.Sprintf("unknown URL error: %s", urlError.Error())
 			}
 
 		} else {
 			ao.Password = "" // redact for logging
-			_, _ = fmt.Fprintf(os.Stderr, "failed to authenticate client with options %+v, Error: %s\n", ao,
+			msg = fmt.Sprintf("failed to authenticate client with options %+v, Error: %s", ao,
 				err.Error())
 		}
 
-		os.Exit(31)
+		return nil, &platformClientError{31, msg}
 	}
 
-	availability := gophercloud.Availability(os.Getenv(manager.InterfaceKey))
+	availability := gophercloud.Availability(lookup(manager.InterfaceKey))
 	if availability == "" {
 		availability = gophercloud.AvailabilityPublic
 	}
@@ -229,15 +223,14 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		Name:         manager.SystemEndpointName,
 		Type:         manager.SystemEndpointType,
 		Availability: availability,
-		Region:       os.Getenv(manager.RegionNameKey),
+		Region:       lookup(manager.RegionNameKey),
 	}
 
 	// Get the system API URL
 	url, err := provider.EndpointLocator(endpointOpts)
 	if err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to find endpoint location for opts %+v: %s\n",
-			endpointOpts, err.Error())
-		os.Exit(32)
+		return nil, &platformClientError{32,
+			fmt.Sprintf("failed to find endpoint location for opts %+v: %s", endpointOpts, err.Error())}
 	}
 
 	// Combine the target endpoint information with the keystone client to form
@@ -247,98 +240,203 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		Endpoint:       url,
 		ResourceBase:   url}
 
-	builder := build.NewDeploymentBuilder(client, namespace, name, os.Stdout)
+	return client, nil
+}
 
-	profileFilters := make([]build.ProfileFilter, 0)
+// exitWithClientError reports why a client for the system API could not be
+// created and exits with the matching exit code.
+func exitWithClientError(err error) {
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", err.Error())
 
-	if noDefaults {
-		profileFilters = append(profileFilters,
-			build.NewInterfaceUnusedFilter())
+	var clientErr *platformClientError
+	if errors.As(err, &clientErr) {
+		os.Exit(clientErr.exitCode)
 	}
+	os.Exit(31)
+}
 
-	if noDefaults && !noMemory {
-		profileFilters = append(profileFilters, build.NewMemoryDefaultsFilter())
-	} else if noMemory {
-		profileFilters = append(profileFilters, build.NewMemoryClearAllFilter())
+// newPlatformClient authenticates with the system using the credentials
+// sourced to the current environment variables and returns a client that
+// can be used to communicate with the system API.
+func newPlatformClient() *gophercloud.ServiceClient {
+	client, err := connectPlatformClient(os.Getenv)
+	if err != nil {
+		exitWithClientError(err)
 	}
 
-	if noDefaults && !noProcessors {
-		profileFilters = append(profileFilters, build.NewProcessorDefaultsFilter())
-	} else if noProcessors {
-		profileFilters = append(profileFilters, build.NewProcessorClearAllFilter())
-	}
+	return client
+}
 
-	if noInterfaceDefaults {
-		profileFilters = append(profileFilters, build.NewInterfaceDefaultsFilter())
+// openSnapshotClient loads a previously captured snapshot and returns a client
+// which replays its responses through a local stand-in for the system API.
+// The returned function stops the stand-in server and must be called once the
+// client is no longer needed.
+func openSnapshotClient(filename string) (*gophercloud.ServiceClient, func(), error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, &platformClientError{33,
+			fmt.Sprintf("failed to open snapshot file: %s", err.Error())}
 	}
 
-	if normalizeInterfaces {
-		profileFilters = append(profileFilters, build.NewInterfaceNamingFilter())
-	}
+	defer func() { _ = file.Close() }()
 
-	if normalizeMTU {
-		profileFilters = append(profileFilters, build.NewInterfaceMTUFilter())
+	snapshot, err := build.ReadSnapshot(file)
+	if err != nil {
+		return nil, nil, &platformClientError{34,
+			fmt.Sprintf("failed to read snapshot file %s: %s", filename, err.Error())}
 	}
 
-	if normalizeConsole {
-		profileFilters = append(profileFilters, build.NewConsoleNameFilter())
-	}
+	server := build.NewSnapshotServer(snapshot)
 
-	if noSysVg {
-		profileFilters = append(profileFilters, build.NewVolumeGroupSystemFilter())
+	return build.NewSnapshotClient(server), server.Close, nil
+}
+
+// newSystemClient returns a client to be used to extract the configuration
+// of the system, either from the running system or from a snapshot if one
+// was selected on the command line.  The returned function releases the
+// client once it is no longer needed.
+func newSystemClient(cmd *cobra.Command) (*gophercloud.ServiceClient, func()) {
+	filename, err := cmd.Flags().GetString(FromSnapshotArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			FromSnapshotArg)
+		os.Exit(35)
 	}
 
-	if len(profileFilters) > 0 {
-		builder.AddProfileFilters(profileFilters)
+	if filename != "" {
+		client, closeClient, err := openSnapshotClient(filename)
+		if err != nil {
+			exitWithClientError(err)
+		}
+
+		return client, closeClient
 	}
 
-	systemFilters := make([]build.SystemFilter, 0)
+	return newPlatformClient(), func() {}
+}
 
-	if noDRBDLinkUtilization {
-		systemFilters = append(systemFilters, build.NewDRBDLinkUtilizationFilter())
+// addBuilderFilters adds the filters selected on the command line to the
+// deployment builder.
+func addBuilderFilters(builder build.Builder, config *build.FilterConfig) {
+	err := config.Apply(builder)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+		os.Exit(21)
 	}
+}
+
+// builderOptions holds the builder settings selected on the command line
+// which do not depend on the system being extracted.
+type builderOptions struct {
+	filters        *build.FilterConfig
+	factorProfiles bool
+	secretSources  []build.SecretSource
+}
+
+// getBuilderOptions reads the filter, profile factoring and secret source
+// arguments.
+func getBuilderOptions(cmd *cobra.Command) *builderOptions {
+	filters := getFilterConfig(cmd)
 
-	if noFileSystems {
-		systemFilters = append(systemFilters, build.NewFileSystemFilter())
+	factor, err := cmd.Flags().GetBool(FactorProfilesArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			FactorProfilesArg)
+		os.Exit(17)
 	}
 
-	if noCACertificates {
-		systemFilters = append(systemFilters, build.NewCACertificateFilter())
+	specs, err := cmd.Flags().GetStringArray(SecretsFromArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			SecretsFromArg)
+		os.Exit(26)
 	}
 
-	if noServiceParams {
-		systemFilters = append(systemFilters, build.NewNoServiceParametersSystemFilter())
+	sources := make([]build.SecretSource, 0, len(specs))
+	for _, spec := range specs {
+		source, err := build.ParseSecretSource(spec)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+			os.Exit(27)
+		}
+
+		sources = append(sources, source)
 	}
 
-	if len(systemFilters) > 0 {
-		builder.AddSystemFilters(systemFilters)
+	return &builderOptions{
+		filters:        filters,
+		factorProfiles: factor,
+		secretSources:  sources,
 	}
+}
 
-	platformNetworkFilters := make([]build.PlatformNetworkFilter, 0)
+// configureBuilder applies the builder options to a deployment builder.
+// Each builder is given its own filter instances.
+func configureBuilder(builder build.Builder, options *builderOptions) {
+	addBuilderFilters(builder, options.filters)
 
-	if noCorePlatformNetworks {
-		platformNetworkFilters = append(platformNetworkFilters, build.NewCoreNetworkFilter())
+	if options.factorProfiles {
+		builder.EnableProfileFactoring()
 	}
 
-	if len(platformNetworkFilters) > 0 {
-		builder.AddPlatformNetworkFilters(platformNetworkFilters)
+	if len(options.secretSources) > 0 {
+		builder.AddSecretSources(options.secretSources)
 	}
+}
 
-	deployment, err := builder.Build()
-	if err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "failed to build deployment details: %s\n", err.Error())
-		os.Exit(40)
-	}
+// newDeploymentBuilder creates a deployment builder for the running system
+// using the names and filters selected on the command line.  The returned
+// function releases the system client once the deployment has been built.
+func newDeploymentBuilder(cmd *cobra.Command, progressWriter io.Writer) (build.Builder, func()) {
+	namespace, name := getBuilderNames(cmd)
+	options := getBuilderOptions(cmd)
+
+	client, closeClient := newSystemClient(cmd)
+
+	builder := build.NewDeploymentBuilder(client, namespace, name, progressWriter)
+
+	configureBuilder(builder, options)
+
+	return builder, closeClient
+}
 
+// addBuilderFlags registers the arguments shared by all subcommands that
+// extract the configuration from a running system.
+func addBuilderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(SystemNameArg, "s", "", "The name of the system to be created")
+	cmd.Flags().StringP(NamespaceNameArg, "n", "deployment", "The name of the namespace used to contain the system")
+	cmd.Flags().String(FromSnapshotArg, "", "Replay a snapshot captured with the capture subcommand instead of accessing a running system")
+	addBuilderOptionFlags(cmd)
+}
+
+// addBuilderOptionFlags registers the filter, profile factoring and secret
+// source arguments.
+func addBuilderOptionFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP(NoDefaultsFilterArg, "f", false, "Exclude all unwanted default fields for initial config")
+	cmd.Flags().Bool(NoCACertificatesFilterArg, false, "Exclude all trusted CA certificates from system instances")
+	cmd.Flags().Bool(NoMemoryFilterArg, false, "Exclude all memory configurations from profiles")
+	cmd.Flags().Bool(NoProcessorFilterArg, false, "Exclude all processor configurations from profiles")
+	cmd.Flags().Bool(NoInterfaceDefaultsFilterArg, false, "Exclude all interface default values from profiles")
+	cmd.Flags().Bool(NoSysVgFilterArg, false, "Exclude system volume groups")
+	cmd.Flags().Bool(NoServiceParametersFilterArg, false, "Exclude service parameters")
+	cmd.Flags().Bool(NormalizeInterfaceNamesFilterArg, false, "Normalize interface names")
+	cmd.Flags().Bool(NormalizeInterfaceMTUFilterArg, false, "Normalize interface MTU values")
+	cmd.Flags().Bool(NormalizeConsoleFilterArg, false, "Normalize serial console attributes")
+	cmd.Flags().Bool(MinimalConfigFilterArg, false, "Shorthand notation for adding all available filters")
+	cmd.Flags().String(FiltersConfigArg, "", "Read the list of filters to be applied from a YAML file")
+	cmd.Flags().StringArray(SecretsFromArg, nil, "Fill in incomplete secrets from a source; one of \"dir:<path>\", \"env[:<prefix>]\" or \"keyring:<path>\" (may be repeated)")
+	cmd.Flags().Bool(FactorProfilesArg, false, "Move attributes shared across host profiles into common base profiles")
+}
+
+// writeYAMLOutput stores the deployment as a single multi-document YAML file.
+func writeYAMLOutput(deployment *build.Deployment, outputFile *os.File, header string) {
 	yamlBuf, err := deployment.ToYAML()
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to convert deployment struct to YAML: %s\n", err.Error())
 		os.Exit(41)
 	}
 
-	_, err = fmt.Fprintf(outputFile, "# Generated: %s\n# Tool version: %s\n",
-		time.Now().Format(time.UnixDate),
-		VersionToString())
+	_, err = fmt.Fprintf(outputFile, "%s", header)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output file: %s\n", err.Error())
 		os.Exit(42)
@@ -355,6 +453,181 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		_, _ = fmt.Fprintf(os.Stderr, "failed to close output file: %s\n", err.Error())
 		os.Exit(43)
 	}
+}
+
+// writeTemplateValues stores the values replaced by template placeholders.
+func writeTemplateValues(values build.TemplateValues, filename string, header string) {
+	buf, err := yaml.Marshal(values)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to convert template values to YAML: %s\n", err.Error())
+		os.Exit(45)
+	}
+
+	err = os.WriteFile(filename, append([]byte(header), buf...), 0600)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write template values file: %s\n", err.Error())
+		os.Exit(46)
+	}
+
+	fmt.Printf("wrote %d template value(s) to %s\n", len(values), filename)
+}
+
+// writeSecretsReport stores the list of secrets which must still be
+// completed by hand in JSON format.
+func writeSecretsReport(deployment *build.Deployment, filename string) {
+	buf, err := json.MarshalIndent(build.NewSecretsReport(deployment), "", "  ")
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to convert secrets report to JSON: %s\n", err.Error())
+		os.Exit(47)
+	}
+
+	err = os.WriteFile(filename, append(buf, '\n'), 0644)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write secrets report: %s\n", err.Error())
+		os.Exit(48)
+	}
+}
+
+// writeBuildReport stores the account of the actions taken by the filters
+// and the summary of the deployment in JSON format.
+func writeBuildReport(report *build.BuildReport, filename string) {
+	buf, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to convert build report to JSON: %s\n", err.Error())
+		os.Exit(36)
+	}
+
+	err = os.WriteFile(filename, append(buf, '\n'), 0644)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write build report: %s\n", err.Error())
+		os.Exit(37)
+	}
+}
+
+// writeKustomizeOutput stores the deployment as a kustomize directory tree.
+func writeKustomizeOutput(deployment *build.Deployment, outputDir string, header string) {
+	files, err := deployment.ToKustomize(header)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to convert deployment struct to kustomize files: %s\n", err.Error())
+		os.Exit(41)
+	}
+
+	err = build.WriteKustomizeFiles(outputDir, files)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output directory: %s\n", err.Error())
+		os.Exit(42)
+	}
+}
+
+func CollectCmdRun(cmd *cobra.Command, args []string) {
+	var outputFile *os.File
+
+	format, err := cmd.Flags().GetString(OutputFormatArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputFormatArg)
+		os.Exit(22)
+	}
+
+	outputFilename, err := cmd.Flags().GetString(OutputFileNameArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputFileNameArg)
+		os.Exit(2)
+	}
+
+	templatize, err := cmd.Flags().GetBool(TemplatizeArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			TemplatizeArg)
+		os.Exit(24)
+	}
+
+	valuesFilename, err := cmd.Flags().GetString(ValuesFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			ValuesFileArg)
+		os.Exit(25)
+	}
+
+	reportFilename, err := cmd.Flags().GetString(SecretsReportArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			SecretsReportArg)
+		os.Exit(28)
+	}
+
+	buildReportFilename, err := cmd.Flags().GetString(ReportArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			ReportArg)
+		os.Exit(29)
+	}
+
+	switch format {
+	case YAMLOutputFormat:
+		outputFile, err = os.Create(outputFilename)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to open output file: %s\n",
+				err.Error())
+			os.Exit(1)
+		}
+
+	case KustomizeOutputFormat:
+		if !cmd.Flags().Changed(OutputFileNameArg) {
+			outputFilename = strings.TrimSuffix(outputFilename, filepath.Ext(outputFilename))
+		}
+
+		err = build.CheckKustomizeDirectory(outputFilename)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+			os.Exit(1)
+		}
+
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "unsupported output format %q; expected %q or %q\n",
+			format, YAMLOutputFormat, KustomizeOutputFormat)
+		os.Exit(23)
+	}
+
+	builder, closeClient := newDeploymentBuilder(cmd, os.Stdout)
+
+	if buildReportFilename != "" {
+		builder.EnableReport()
+	}
+
+	deployment, err := builder.Build()
+	closeClient()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to build deployment details: %s\n", err.Error())
+		os.Exit(40)
+	}
+
+	header := fmt.Sprintf("# Generated: %s\n# Tool version: %s\n",
+		time.Now().Format(time.UnixDate),
+		VersionToString())
+
+	if templatize {
+		if valuesFilename == "" {
+			valuesFilename = strings.TrimSuffix(outputFilename, filepath.Ext(outputFilename)) + "-values.yaml"
+		}
+
+		writeTemplateValues(build.Templatize(deployment), valuesFilename, header)
+	}
+
+	if format == KustomizeOutputFormat {
+		writeKustomizeOutput(deployment, outputFilename, header)
+	} else {
+		writeYAMLOutput(deployment, outputFile, header)
+	}
+
+	if reportFilename != "" {
+		writeSecretsReport(deployment, reportFilename)
+	}
+
+	if buildReportFilename != "" {
+		writeBuildReport(builder.Report(), buildReportFilename)
+	}
 
 	fmt.Printf("done.\n")
 
@@ -364,7 +637,11 @@ func CollectCmdRun(cmd *cobra.Command, args []string) {
 		fmt.Printf("  retrievable from the system.  For example, any BMC Secrets must be\n")
 		fmt.Printf("  edited to add the password and any SSL Secrets must be edited to add\n")
 		fmt.Printf("  the certificate and key information.  Any such information must be\n")
-		fmt.Printf("  added in base64 encoded format.\n")
+		if format == KustomizeOutputFormat {
+			fmt.Printf("  added in plain text to the files listed in the secretGenerator.\n")
+		} else {
+			fmt.Printf("  added in base64 encoded format.\n")
+		}
 		os.Exit(44)
 	}
 }
@@ -377,7 +654,12 @@ var collectCmd = &cobra.Command{
 The yaml output from this tool must be manually verified and updated to fill-in
 fields that are otherwise not automatically settable (i.e., secrets,
 certificates).  This command requires that the Openstack credentials be sourced
-to the current environment variables.`,
+to the current environment variables unless a snapshot is replayed with the
+--from-snapshot option.  The set of filters applied to the output can be
+selected with the filter shorthand options, with a YAML file supplied with the
+--filters-config option, or both.  The --templatize option replaces the site
+specific values with placeholders which can be filled in with the
+render-template subcommand.`,
 	Run: CollectCmdRun,
 }
 
@@ -385,18 +667,11 @@ func init() {
 	rootCmd.AddCommand(collectCmd)
 
 	// Here you will define your flags and configuration settings.
-	collectCmd.Flags().StringP(OutputFileNameArg, "o", "deployment-config.yaml", "A destination path used for output.")
-	collectCmd.Flags().StringP(SystemNameArg, "s", "", "The name of the system to be created")
-	collectCmd.Flags().StringP(NamespaceNameArg, "n", "deployment", "The name of the namespace used to contain the system")
-	collectCmd.Flags().BoolP(NoDefaultsFilterArg, "f", false, "Exclude all unwanted default fields for initial config")
-	collectCmd.Flags().Bool(NoCACertificatesFilterArg, false, "Exclude all trusted CA certificates from system instances")
-	collectCmd.Flags().Bool(NoMemoryFilterArg, false, "Exclude all memory configurations from profiles")
-	collectCmd.Flags().Bool(NoProcessorFilterArg, false, "Exclude all processor configurations from profiles")
-	collectCmd.Flags().Bool(NoInterfaceDefaultsFilterArg, false, "Exclude all interface default values from profiles")
-	collectCmd.Flags().Bool(NoSysVgFilterArg, false, "Exclude system volume groups")
-	collectCmd.Flags().Bool(NoServiceParametersFilterArg, false, "Exclude service parameters")
-	collectCmd.Flags().Bool(NormalizeInterfaceNamesFilterArg, false, "Normalize interface names")
-	collectCmd.Flags().Bool(NormalizeInterfaceMTUFilterArg, false, "Normalize interface MTU values")
-	collectCmd.Flags().Bool(NormalizeConsoleFilterArg, false, "Normalize serial console attributes")
-	collectCmd.Flags().Bool(MinimalConfigFilterArg, false, "Shorthand notation for adding all available filters")
+	collectCmd.Flags().StringP(OutputFileNameArg, "o", defaultOutputFileName, "A destination path used for output.")
+	collectCmd.Flags().Bool(TemplatizeArg, false, "Replace site specific values with placeholders and store the values in a separate file")
+	collectCmd.Flags().String(ValuesFileArg, "", "The file used to store the template values (defaults to the output path with a \"-values.yaml\" suffix)")
+	collectCmd.Flags().String(SecretsReportArg, "", "Write the list of secrets that are still incomplete to a JSON file")
+	collectCmd.Flags().String(ReportArg, "", "Write a report of the changes made by each filter and a summary of the output to a JSON file")
+	collectCmd.Flags().String(OutputFormatArg, YAMLOutputFormat, "The output format; either \"yaml\" for a single file or \"kustomize\" for a directory tree")
+	addBuilderFlags(collectCmd)
 }
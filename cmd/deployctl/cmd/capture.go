@@ -0,0 +1,80 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+)
+
+func CaptureCmdRun(cmd *cobra.Command, args []string) {
+	var outputFile *os.File
+
+	if outputFilename, err := cmd.Flags().GetString(OutputFileNameArg); err == nil {
+		outputFile, err = os.Create(outputFilename)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to open output file: %s\n",
+				err.Error())
+			os.Exit(1)
+		}
+	} else {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputFileNameArg)
+		os.Exit(2)
+	}
+
+	client := newPlatformClient()
+	recorder := build.NewSnapshotRecorder(client)
+
+	// Run the full builder pipeline so that every response required to
+	// rebuild the deployment is recorded.  The resulting deployment is of no
+	// interest since filters are applied when the snapshot is replayed.
+	fmt.Printf("capturing system API responses\n")
+	builder := build.NewDeploymentBuilder(client, "deployment", "capture", io.Discard)
+
+	_, err := builder.Build()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to capture deployment details: %s\n", err.Error())
+		os.Exit(40)
+	}
+
+	snapshot := recorder.Snapshot()
+
+	err = snapshot.Write(outputFile)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output file: %s\n", err.Error())
+		os.Exit(42)
+	}
+
+	err = outputFile.Close()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to close output file: %s\n", err.Error())
+		os.Exit(43)
+	}
+
+	fmt.Printf("captured %d responses.\ndone.\n", len(snapshot.Entries))
+}
+
+// captureCmd represents the capture command
+var captureCmd = &cobra.Command{
+	Use:   "capture",
+	Short: "The capture subcommand records the system API responses of a running system",
+	Long: `The capture subcommand records every system API response required to extract
+the configuration from a running system into a single snapshot archive.  The
+archive can later be replayed with the --from-snapshot option of the build
+subcommand on a machine that does not have access to the system.  This command
+requires that the Openstack credentials be sourced to the current environment
+variables.`,
+	Run: CaptureCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(captureCmd)
+
+	captureCmd.Flags().StringP(OutputFileNameArg, "o", "system-snapshot.json.gz", "A destination path used for output.")
+}
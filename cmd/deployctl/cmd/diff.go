@@ -0,0 +1,94 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+)
+
+// DiffDriftExitCode is the exit code returned by the diff subcommand when the
+// running system differs from the deployment configuration.
+const DiffDriftExitCode = 1
+
+func DiffCmdRun(cmd *cobra.Command, args []string) {
+	filename := args[0]
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read deployment file: %s\n", err.Error())
+		os.Exit(50)
+	}
+
+	expected, err := build.ParseDeployment(data)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to parse deployment file %s: %s\n", filename, err.Error())
+		os.Exit(51)
+	}
+
+	// Unless explicitly overridden, generate the running configuration with
+	// the same names as the deployment file so that resources can be matched.
+	if !cmd.Flags().Changed(SystemNameArg) && expected.System.Name != "" {
+		_ = cmd.Flags().Set(SystemNameArg, expected.System.Name)
+	}
+
+	if !cmd.Flags().Changed(NamespaceNameArg) && expected.System.Namespace != "" {
+		_ = cmd.Flags().Set(NamespaceNameArg, expected.System.Namespace)
+	}
+
+	// Progress updates are sent to stderr so that the output can be piped.
+	builder, closeClient := newDeploymentBuilder(cmd, os.Stderr)
+
+	observed, err := builder.Build()
+	closeClient()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to build deployment details: %s\n", err.Error())
+		os.Exit(40)
+	}
+
+	deltas, err := build.DiffDeployments(expected, observed)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to compare deployments: %s\n", err.Error())
+		os.Exit(52)
+	}
+
+	if len(deltas) == 0 {
+		fmt.Printf("no drift detected.\n")
+		return
+	}
+
+	fmt.Printf("%s", build.DeltaToString(deltas))
+	os.Exit(DiffDriftExitCode)
+}
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <deployment-file>",
+	Short: "The diff subcommand compares a running system against a deployment configuration",
+	Long: `The diff subcommand extracts the configuration from a running system and
+compares it, resource by resource, against an existing deployment configuration
+file.  Resources are matched by kind and name and any added, removed, or changed
+attributes are reported.  Lines prefixed with "-" show the value observed on the
+running system and lines prefixed with "+" show the value from the deployment
+configuration.  Secrets are not compared.
+
+The same filters accepted by the build subcommand should be used so that the
+running configuration is rendered the same way as the deployment file.  Unless
+specified, the system and namespace names are taken from the deployment file.
+
+The command exits with 0 if no drift was found, 1 if drift was found, and any
+other value if the comparison could not be completed.  This command requires
+that the Openstack credentials be sourced to the current environment variables.`,
+	Args: cobra.ExactArgs(1),
+	Run:  DiffCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	addBuilderFlags(diffCmd)
+}
@@ -0,0 +1,76 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+)
+
+func SealKeyringCmdRun(cmd *cobra.Command, args []string) {
+	filename, err := cmd.Flags().GetString(DeploymentFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			DeploymentFileArg)
+		os.Exit(2)
+	} else if filename == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "keyring content file name must not be blank\n")
+		os.Exit(3)
+	}
+
+	outputFilename, err := cmd.Flags().GetString(OutputFileNameArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputFileNameArg)
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read keyring content file: %s\n", err.Error())
+		os.Exit(4)
+	}
+
+	buf, err := build.SealKeyring(data, os.Getenv(build.KeyringPassphraseEnv))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to seal keyring: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	err = os.WriteFile(outputFilename, buf, 0600)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output file: %s\n", err.Error())
+		os.Exit(5)
+	}
+
+	fmt.Printf("keyring written to %s\n", outputFilename)
+}
+
+// sealKeyringCmd represents the seal-keyring command
+var sealKeyringCmd = &cobra.Command{
+	Use:   "seal-keyring",
+	Short: "The seal-keyring subcommand encrypts a keyring for use with the build subcommand",
+	Long: `The seal-keyring subcommand encrypts a YAML file which maps secret names to
+their attribute names and values so that it can be used as a keyring source of
+the build subcommand --secrets-from option.  The passphrase is read from the
+` + build.KeyringPassphraseEnv + ` environment variable.  For example:
+
+  bmc-secret:
+    password: my-password
+  openstack-cert:
+    tls.crt: |
+      -----BEGIN CERTIFICATE-----
+      ...`,
+	Run: SealKeyringCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(sealKeyringCmd)
+
+	sealKeyringCmd.Flags().StringP(DeploymentFileArg, "f", "", "The plain text keyring content to encrypt")
+	sealKeyringCmd.Flags().StringP(OutputFileNameArg, "o", "keyring.yaml", "A destination path used for output.")
+}
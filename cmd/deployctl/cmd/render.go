@@ -0,0 +1,118 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+)
+
+func RenderTemplateCmdRun(cmd *cobra.Command, args []string) {
+	templateFilename, err := cmd.Flags().GetString(DeploymentFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			DeploymentFileArg)
+		os.Exit(2)
+	} else if templateFilename == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "template file name must not be blank\n")
+		os.Exit(3)
+	}
+
+	valuesFilename, err := cmd.Flags().GetString(ValuesFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			ValuesFileArg)
+		os.Exit(2)
+	} else if valuesFilename == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "values file name must not be blank\n")
+		os.Exit(3)
+	}
+
+	outputFilename, err := cmd.Flags().GetString(OutputFileNameArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			OutputFileNameArg)
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(templateFilename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read template file: %s\n", err.Error())
+		os.Exit(4)
+	}
+
+	buf, err := os.ReadFile(valuesFilename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read values file: %s\n", err.Error())
+		os.Exit(4)
+	}
+
+	values, err := build.ParseTemplateValues(buf)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", valuesFilename, err.Error())
+		os.Exit(5)
+	}
+
+	rendered, err := build.RenderTemplate(data, values)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", templateFilename, err.Error())
+		os.Exit(5)
+	}
+
+	header := fmt.Sprintf("# Rendered: %s\n# Template: %s\n# Tool version: %s\n",
+		time.Now().Format(time.UnixDate),
+		templateFilename,
+		VersionToString())
+
+	err = os.WriteFile(outputFilename, append([]byte(header), rendered...), 0600)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to write to output file: %s\n", err.Error())
+		os.Exit(6)
+	}
+
+	// Validate the rendered output rather than the template so that the
+	// reported line numbers refer to the file that will be applied.
+	docs, err := build.SplitDocuments(append([]byte(header), rendered...))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", outputFilename, err.Error())
+		os.Exit(ValidateFailedExitCode)
+	}
+
+	errs := validateDocuments(docs)
+
+	reportDocumentErrors(outputFilename, docs, errs)
+
+	if len(errs) > 0 {
+		os.Exit(ValidateFailedExitCode)
+	}
+
+	fmt.Printf("%d resource(s) rendered to %s and validated successfully.\n", len(docs), outputFilename)
+}
+
+// renderTemplateCmd represents the render-template command
+var renderTemplateCmd = &cobra.Command{
+	Use:   "render-template",
+	Short: "The render-template subcommand fills in a deployment template",
+	Long: `The render-template subcommand substitutes the values from a values file for
+the placeholders of a deployment template produced by the build subcommand with
+the --templatize option.  The rendered deployment configuration is written to
+the output file and then checked with the same rules as the validate
+subcommand.
+
+The command exits with 0 if the rendered file is valid, 1 if validation errors
+were found, and any other value if the template could not be rendered.`,
+	Run: RenderTemplateCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(renderTemplateCmd)
+
+	renderTemplateCmd.Flags().StringP(DeploymentFileArg, "f", "", "The deployment template file to render")
+	renderTemplateCmd.Flags().String(ValuesFileArg, "", "The file containing the values of the template placeholders")
+	renderTemplateCmd.Flags().StringP(OutputFileNameArg, "o", defaultOutputFileName, "A destination path used for output.")
+}
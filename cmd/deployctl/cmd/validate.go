@@ -0,0 +1,118 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/wind-river/cloud-platform-deployment-manager/build"
+	webhookv1 "github.com/wind-river/cloud-platform-deployment-manager/internal/webhook/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	DeploymentFileArg = "file"
+)
+
+// ValidateFailedExitCode is the exit code returned by the validate subcommand
+// when the deployment configuration contains errors.
+const ValidateFailedExitCode = 1
+
+// validateDocuments runs the admission validation rules and the reference
+// checks against every document of a deployment configuration.
+func validateDocuments(docs []build.Document) []build.DocumentError {
+	// The admission validators log their decisions; they are of no interest
+	// when running outside of the cluster.
+	logf.SetLogger(logr.Discard())
+
+	errs := make([]build.DocumentError, 0)
+	for _, doc := range docs {
+		obj, err := build.DecodeDocument(doc)
+		if err != nil {
+			errs = append(errs, build.DocumentError{Line: doc.Line, Kind: doc.Kind, Name: doc.Name, Err: err})
+			continue
+		}
+
+		if o, ok := obj.(k8sruntime.Object); ok {
+			if err := webhookv1.ValidateOffline(o); err != nil {
+				errs = append(errs, build.DocumentError{Line: doc.Line, Kind: doc.Kind, Name: doc.Name, Err: err})
+			}
+		}
+	}
+
+	return append(errs, build.ValidateReferences(docs)...)
+}
+
+// reportDocumentErrors prints each validation error along with the file name
+// and line number at which it was found.
+func reportDocumentErrors(filename string, docs []build.Document, errs []build.DocumentError) {
+	for _, e := range errs {
+		_, _ = fmt.Fprintf(os.Stderr, "%s:%d: %s/%s: %s\n", filename, e.Line, e.Kind, e.Name, e.Err.Error())
+	}
+
+	if len(errs) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "%d error(s) found in %d resource(s)\n", len(errs), len(docs))
+	}
+}
+
+func ValidateCmdRun(cmd *cobra.Command, args []string) {
+	filename, err := cmd.Flags().GetString(DeploymentFileArg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get %q argument\n",
+			DeploymentFileArg)
+		os.Exit(2)
+	} else if filename == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "deployment file name must not be blank\n")
+		os.Exit(3)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read deployment file: %s\n", err.Error())
+		os.Exit(4)
+	}
+
+	docs, err := build.SplitDocuments(data)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s\n", filename, err.Error())
+		os.Exit(ValidateFailedExitCode)
+	}
+
+	errs := validateDocuments(docs)
+
+	reportDocumentErrors(filename, docs, errs)
+
+	if len(errs) > 0 {
+		os.Exit(ValidateFailedExitCode)
+	}
+
+	fmt.Printf("%d resource(s) validated successfully.\n", len(docs))
+}
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "The validate subcommand checks a deployment configuration without a cluster",
+	Long: `The validate subcommand decodes every resource in a multi-document deployment
+configuration file and runs the same validation rules as the admission webhooks
+against each one.  It also verifies that every resource referenced by name, such
+as host profiles, platform networks, data networks, PTP instances and PTP
+interfaces, is defined within the file.  Rules that depend on the state of the
+cluster are replaced by equivalent checks within the file where possible.
+
+All errors are reported with the file name and line number.  The command exits
+with 0 if the file is valid, 1 if errors were found, and any other value if the
+file could not be read.`,
+	Run: ValidateCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringP(DeploymentFileArg, "f", "", "The deployment configuration file to validate")
+}
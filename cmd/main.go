@@ -29,6 +29,8 @@ import (
 	config2 "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/host"
+	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/system"
 	webhookv1 "github.com/wind-river/cloud-platform-deployment-manager/internal/webhook/v1"
 	//+kubebuilder:scaffold:imports
@@ -240,6 +242,19 @@ func main() {
 	}
 	//+kubebuilder:scaffold:builder
 
+	if err = metrics.RegisterResourceCollector(mgr.GetClient()); err != nil {
+		setupLog.Error(err, "unable to register resource metrics")
+		os.Exit(1)
+	}
+
+	// The monitor debug endpoint is served by the metrics server so that it
+	// is protected by the same authentication and authorization filter.
+	monitorHandler := cloudManager.NewMonitorHandler(cloudManager.GetInstance(mgr))
+	if err = mgr.AddMetricsServerExtraHandler(cloudManager.MonitorDebugPath, monitorHandler); err != nil {
+		setupLog.Error(err, "unable to register monitor debug endpoint")
+		os.Exit(1)
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
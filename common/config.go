@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019 Wind River Systems, Inc. */
+/* Copyright(c) 2019, 2026 Wind River Systems, Inc. */
 
 package common
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	perrors "github.com/pkg/errors"
@@ -20,6 +21,14 @@ var log = logf.Log.WithName("controller").WithName("host")
 // and sub-reconcilers.
 const ReconcilerPrefix = "reconcilers"
 
+// MonitorPrefix defines the viper configuration prefix for all monitor
+// options.
+const MonitorPrefix = "monitors"
+
+// DefaultMonitorType defines the monitor type whose options apply to every
+// monitor type which does not define its own value.
+const DefaultMonitorType = "default"
+
 // ReconcilerName is the type alias that represents the path for a reconciler
 // or sub-reconciler.
 type ReconcilerName string
@@ -108,6 +117,50 @@ type OptionName string
 const (
 	HTTPSRequired   OptionName = "httpsRequired"
 	StopAfterInSync OptionName = "stopAfterInSync"
+
+	// InSyncMode defines what the reconciler does once the resource has been
+	// reconciled a first time.  It takes precedence over StopAfterInSync.
+	InSyncMode OptionName = "inSyncMode"
+
+	// DriftInterval defines how often the system is re-read to detect drift
+	// when the reconciler is in the SyncModeDetect mode (e.g., 10m).
+	DriftInterval OptionName = "driftInterval"
+)
+
+// SyncMode is the type alias that represents the behaviour of a reconciler
+// once its resource has been reconciled a first time.
+type SyncMode string
+
+// Defines the current list of supported in-sync modes.
+const (
+	// SyncModeStop stops processing changes once the resource has been
+	// reconciled unless the resource has the ReconcileAfterInSync annotation.
+	SyncModeStop SyncMode = "stop"
+
+	// SyncModeReconcile continues to correct any difference between the
+	// desired configuration and the system.
+	SyncModeReconcile SyncMode = "reconcile"
+
+	// SyncModeDetect behaves as SyncModeStop but periodically re-reads the
+	// system and reports any difference with the desired configuration as
+	// drift.  The system is never modified to correct the drift.
+	SyncModeDetect SyncMode = "detect"
+)
+
+// DefaultDriftInterval is the interval at which drift is detected if the
+// DriftInterval option is not set.
+const DefaultDriftInterval = 10 * time.Minute
+
+// Defines the current list of supported monitor options.
+const (
+	// MonitorDeadline defines how long a monitor may wait before it is
+	// considered stuck (e.g., 30m).  No deadline is enforced by default.
+	MonitorDeadline OptionName = "deadline"
+
+	// MonitorRetryOnDeadline defines whether the monitor stops and requeues
+	// its resource once the deadline has passed so that the reconciler can
+	// retry the action.  Otherwise, the monitor continues to wait.
+	MonitorRetryOnDeadline OptionName = "retryOnDeadline"
 )
 
 // reconcilerOptionDefaults is the default value for each reconciler option.
@@ -161,6 +214,12 @@ func ReconcilerOptionPath(name ReconcilerName, option OptionName) string {
 	return fmt.Sprintf("%s.%s", ReconcilerConfigPath(name), option)
 }
 
+// MonitorOptionPath returns the config attribute path which represents the
+// option value of the specified monitor type.
+func MonitorOptionPath(monitorType string, option OptionName) string {
+	return fmt.Sprintf("%s.%s.%s", MonitorPrefix, monitorType, option)
+}
+
 // ReadConfig is a utility which loads the current manager configuration into
 // memory.
 func ReadConfig() (err error) {
@@ -220,6 +279,101 @@ func GetReconcilerOptionBool(name ReconcilerName, option OptionName, defaultValu
 	return defaultValue
 }
 
+// GetReconcilerOptionDuration returns the value of the specified option as a
+// Duration value; otherwise the specified default value is returned if the
+// option does not exist.  Numeric values are interpreted as seconds.
+func GetReconcilerOptionDuration(name ReconcilerName, option OptionName, defaultValue time.Duration) time.Duration {
+	return toDuration(option, GetReconcilerOption(name, option), defaultValue)
+}
+
+// GetReconcilerSyncMode returns the in-sync mode of the specified reconciler.
+// If the InSyncMode option is not set, or is invalid, the mode is derived
+// from the StopAfterInSync option so that existing configurations keep their
+// behaviour.
+func GetReconcilerSyncMode(name ReconcilerName) SyncMode {
+	value := GetReconcilerOption(name, InSyncMode)
+	if value != nil {
+		mode, _ := value.(string)
+		switch SyncMode(mode) {
+		case SyncModeStop, SyncModeReconcile, SyncModeDetect:
+			return SyncMode(mode)
+		}
+
+		log.Info("invalid in-sync mode option", "name", string(name), "value", value)
+	}
+
+	// If the option is not found or the option was specified in a form other
+	// than a bool then assume the safest default value possible.
+	if GetReconcilerOptionBool(name, StopAfterInSync, true) {
+		return SyncModeStop
+	}
+
+	return SyncModeReconcile
+}
+
+// GetMonitorOption returns the value of the specified option for a monitor
+// type.  The value configured for the default monitor type is returned if the
+// monitor type does not define its own; otherwise nil is returned.
+func GetMonitorOption(monitorType string, option OptionName) interface{} {
+	path := MonitorOptionPath(monitorType, option)
+	if cfg.IsSet(path) {
+		return cfg.Get(path)
+	}
+
+	return cfg.Get(MonitorOptionPath(DefaultMonitorType, option))
+}
+
+// GetMonitorOptionDuration returns the value of the specified monitor option
+// as a Duration value; otherwise the specified default value is returned if
+// the option does not exist.  Numeric values are interpreted as seconds.
+func GetMonitorOptionDuration(monitorType string, option OptionName, defaultValue time.Duration) time.Duration {
+	return toDuration(option, GetMonitorOption(monitorType, option), defaultValue)
+}
+
+// toDuration converts an option value to a Duration value; otherwise the
+// specified default value is returned if the value is missing or invalid.
+func toDuration(option OptionName, value interface{}, defaultValue time.Duration) time.Duration {
+	switch v := value.(type) {
+	case nil:
+		break
+	case string:
+		if result, err := time.ParseDuration(v); err == nil {
+			return result
+		}
+		log.Info("invalid duration option", "option", option, "value", v)
+	case int:
+		return time.Duration(v) * time.Second
+	case int64:
+		return time.Duration(v) * time.Second
+	case float64:
+		return time.Duration(v * float64(time.Second))
+	default:
+		log.Info("unexpected option type",
+			"option", option, "type", reflect.TypeOf(value))
+	}
+
+	// Return the caller's default if not found.
+	return defaultValue
+}
+
+// GetMonitorOptionBool returns the value of the specified monitor option as a
+// Bool value; otherwise the specified default value is returned if the option
+// does not exist.
+func GetMonitorOptionBool(monitorType string, option OptionName, defaultValue bool) bool {
+	value := GetMonitorOption(monitorType, option)
+	if value != nil {
+		if result, ok := value.(bool); ok {
+			return result
+		} else {
+			log.Info("unexpected option type",
+				"option", option, "type", reflect.TypeOf(value))
+		}
+	}
+
+	// Return the caller's default if not found.
+	return defaultValue
+}
+
 func init() {
 	cfg = viper.New()
 
@@ -0,0 +1,83 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config utils", func() {
+	Describe("Monitor options", func() {
+		AfterEach(func() {
+			cfg.Set(MonitorOptionPath(DefaultMonitorType, MonitorDeadline), nil)
+			cfg.Set(MonitorOptionPath("stateMonitor", MonitorDeadline), nil)
+			cfg.Set(MonitorOptionPath("stateMonitor", MonitorRetryOnDeadline), nil)
+		})
+		Context("when no option is configured", func() {
+			It("should return the caller's default", func() {
+				Expect(GetMonitorOptionDuration("stateMonitor", MonitorDeadline, 0)).To(Equal(time.Duration(0)))
+				Expect(GetMonitorOptionBool("stateMonitor", MonitorRetryOnDeadline, false)).To(BeFalse())
+			})
+		})
+		Context("when only the default monitor type is configured", func() {
+			It("should return the default monitor type value", func() {
+				cfg.Set(MonitorOptionPath(DefaultMonitorType, MonitorDeadline), "1h")
+				Expect(GetMonitorOptionDuration("stateMonitor", MonitorDeadline, 0)).To(Equal(time.Hour))
+			})
+		})
+		Context("when the monitor type is configured", func() {
+			It("should override the default monitor type value", func() {
+				cfg.Set(MonitorOptionPath(DefaultMonitorType, MonitorDeadline), "1h")
+				cfg.Set(MonitorOptionPath("stateMonitor", MonitorDeadline), 600)
+				cfg.Set(MonitorOptionPath("stateMonitor", MonitorRetryOnDeadline), true)
+				Expect(GetMonitorOptionDuration("stateMonitor", MonitorDeadline, 0)).To(Equal(10 * time.Minute))
+				Expect(GetMonitorOptionDuration("partitionStateMonitor", MonitorDeadline, 0)).To(Equal(time.Hour))
+				Expect(GetMonitorOptionBool("stateMonitor", MonitorRetryOnDeadline, false)).To(BeTrue())
+			})
+		})
+		Context("when the value is invalid", func() {
+			It("should return the caller's default", func() {
+				cfg.Set(MonitorOptionPath("stateMonitor", MonitorDeadline), "forever")
+				Expect(GetMonitorOptionDuration("stateMonitor", MonitorDeadline, time.Minute)).To(Equal(time.Minute))
+			})
+		})
+	})
+	Describe("Reconciler in-sync mode", func() {
+		AfterEach(func() {
+			cfg.Set(ReconcilerOptionPath(Host, InSyncMode), nil)
+			cfg.Set(ReconcilerOptionPath(Host, StopAfterInSync), nil)
+			cfg.Set(ReconcilerOptionPath(Host, DriftInterval), nil)
+		})
+		Context("when the mode is not configured", func() {
+			It("should derive the mode from the stopAfterInSync option", func() {
+				Expect(GetReconcilerSyncMode(Host)).To(Equal(SyncModeStop))
+				cfg.Set(ReconcilerOptionPath(Host, StopAfterInSync), false)
+				Expect(GetReconcilerSyncMode(Host)).To(Equal(SyncModeReconcile))
+			})
+		})
+		Context("when the mode is configured", func() {
+			It("should take precedence over the stopAfterInSync option", func() {
+				cfg.Set(ReconcilerOptionPath(Host, StopAfterInSync), false)
+				cfg.Set(ReconcilerOptionPath(Host, InSyncMode), "detect")
+				Expect(GetReconcilerSyncMode(Host)).To(Equal(SyncModeDetect))
+			})
+		})
+		Context("when the mode is invalid", func() {
+			It("should fall back to the stopAfterInSync option", func() {
+				cfg.Set(ReconcilerOptionPath(Host, InSyncMode), "correct")
+				Expect(GetReconcilerSyncMode(Host)).To(Equal(SyncModeStop))
+			})
+		})
+		Context("when the drift interval is configured", func() {
+			It("should return the configured interval", func() {
+				Expect(GetReconcilerOptionDuration(Host, DriftInterval, DefaultDriftInterval)).To(Equal(DefaultDriftInterval))
+				cfg.Set(ReconcilerOptionPath(Host, DriftInterval), "30m")
+				Expect(GetReconcilerOptionDuration(Host, DriftInterval, DefaultDriftInterval)).To(Equal(30 * time.Minute))
+			})
+		})
+	})
+})
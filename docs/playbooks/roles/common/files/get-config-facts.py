@@ -1,14 +1,14 @@
 #!/usr/bin/env python3
 """
 SPDX-License-Identifier: Apache-2.0
-# Copyright(c) 2025 Wind River Systems, Inc.
+# Copyright(c) 2025-2026 Wind River Systems, Inc.
 
 DM Configuration Parser
 
 Parses YAML deployment configuration file to extract namespace and deployment scope.
 Identifies:
 - namespace from host resources (defaults to 'deployment')
-- principal deployment scope flag from resource status
+- principal deployment scope flag from resource annotations or status
 - hosts configured
 
 Usage: python3 get-config-facts.py <deploy-config.yaml>
@@ -19,6 +19,8 @@ import json
 import sys
 import yaml
 
+SCOPE_ANNOTATION = "deployment-manager/deployment-scope"
+
 
 def safe_get_namespace(resource: dict) -> str:
     """Extract namespace from resource metadata, defaulting to 'deployment'.
@@ -41,14 +43,23 @@ def safe_get_namespace(resource: dict) -> str:
 
 
 def safe_get_principal_scope(resource: dict) -> bool:
-    """Check if resource has deploymentScope set to 'principal'.
+    """Check if resource has its deployment scope set to 'principal'.
+
+    The deployment-manager/deployment-scope annotation takes precedence over
+    the deprecated deploymentScope status attribute.
 
     Returns:
-        bool: True if deploymentScope is 'principal', False otherwise
+        bool: True if the deployment scope is 'principal', False otherwise
     """
     if not isinstance(resource, dict):
         return False
 
+    metadata = resource.get("metadata", {})
+    if isinstance(metadata, dict):
+        annotations = metadata.get("annotations", {})
+        if isinstance(annotations, dict) and SCOPE_ANNOTATION in annotations:
+            return annotations[SCOPE_ANNOTATION] == "principal"
+
     status = resource.get("status", {})
     if not isinstance(status, dict):
         return False
@@ -61,7 +72,7 @@ def parse_config(file_path: str) -> dict:
     """Parse dm yaml deployment configuration to extract namespace and deployment scope.
 
     Extracts the namespace from any host resource and sets principal to True
-    if any resource has deploymentScope set to 'principal'.
+    if any resource has its deployment scope set to 'principal'.
 
     Returns:
         dict: Configuration facts with 'namespace' (from host resource),
@@ -13,6 +13,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -203,13 +204,16 @@ func (r *AddressPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logAddressPool}
+		Logger:       logAddressPool,
+		Kind:         "AddressPool"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(AddressPoolControllerName),
 		Logger:        logAddressPool}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.AddressPool{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("AddressPool",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
@@ -9,8 +9,8 @@ import (
 	"net"
 	"net/url"
 	"reflect"
-	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -18,6 +18,7 @@ import (
 	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -151,9 +152,6 @@ var (
 	}
 )
 
-// Constant for processLines and searchParameters when gathering the Delta config
-const ParentFound = "parent_found"
-
 // Common event record reasons
 const (
 	ResourceCreated    = "Created"
@@ -162,6 +160,8 @@ const (
 	ResourceWait       = "Wait"
 	ResourceDependency = "Dependency"
 	ResourceNotified   = "Notified"
+	ResourcePlanned    = "Planned"
+	ResourceDrifted    = "Drifted"
 )
 
 func FormatStruct(obj interface{}) string {
@@ -186,6 +186,85 @@ type ReconcilerErrorHandler interface {
 type ErrorHandler struct {
 	logr.Logger
 	manager.CloudManager
+
+	// Kind is the resource kind handled by the reconciler.  It is used to
+	// label the reconcile error metrics and to look up resources when
+	// updating their conditions.
+	Kind string
+
+	// lastErrors records the last error handled for each request so that it
+	// can be reflected in the resource conditions.
+	lastErrors sync.Map
+}
+
+// Defines the error class values used to label the reconcile error metrics.
+const (
+	ErrorClassUnlock               = "unlock"
+	ErrorClassAuthentication       = "authentication"
+	ErrorClassUser                 = "user"
+	ErrorClassServer               = "server"
+	ErrorClassKubernetes           = "kubernetes"
+	ErrorClassNetwork              = "network"
+	ErrorClassHTTPSRequired        = "https-required"
+	ErrorClassChangeAfterReconcile = "change-after-reconciled"
+	ErrorClassValidation           = "validation"
+	ErrorClassDependency           = "dependency"
+	ErrorClassStatusDependency     = "status-dependency"
+	ErrorClassHostNotify           = "host-notify"
+	ErrorClassPlatformNetwork      = "platform-network"
+	ErrorClassUserData             = "user-data"
+	ErrorClassWaitForMonitor       = "wait-for-monitor"
+	ErrorClassNotFound             = "not-found"
+	ErrorClassUnhandled            = "unhandled"
+)
+
+// ErrorClass maps a reconciler error to one of a fixed set of error classes
+// so that errors can be counted without creating a label value per error
+// message.
+func ErrorClass(in error) string {
+	cause := perrors.Cause(in)
+
+	switch cause.(type) {
+	case ErrUnlockError:
+		return ErrorClassUnlock
+	case *gophercloud.ErrUnableToReauthenticate, gophercloud.ErrUnableToReauthenticate,
+		gophercloud.ErrDefault401:
+		return ErrorClassAuthentication
+	case gophercloud.ErrDefault400, gophercloud.ErrDefault403,
+		gophercloud.ErrDefault404, gophercloud.ErrDefault405:
+		return ErrorClassUser
+	case gophercloud.ErrDefault500, gophercloud.ErrDefault503:
+		return ErrorClassServer
+	case *errors.StatusError:
+		return ErrorClassKubernetes
+	case *url.Error:
+		return ErrorClassNetwork
+	case HTTPSClientRequired:
+		return ErrorClassHTTPSRequired
+	case ChangeAfterReconciled:
+		return ErrorClassChangeAfterReconcile
+	case ValidationError:
+		return ErrorClassValidation
+	case ErrSystemDependency, ErrResourceConfigurationDependency:
+		return ErrorClassDependency
+	case ErrResourceStatusDependency:
+		return ErrorClassStatusDependency
+	case HostNotifyError:
+		return ErrorClassHostNotify
+	case PlatformNetworkReconciliationError:
+		return ErrorClassPlatformNetwork
+	case manager.ClientError, ErrUserDataError,
+		starlingxv1.ErrMissingSystemResource, ErrMissingKubernetesResource:
+		return ErrorClassUserData
+	case manager.WaitForMonitor:
+		return ErrorClassWaitForMonitor
+	}
+
+	if errors.IsNotFound(cause) {
+		return ErrorClassNotFound
+	}
+
+	return ErrorClassUnhandled
 }
 
 func (h *ErrorHandler) webhookUnavailable(err error) bool {
@@ -208,6 +287,13 @@ func (h *ErrorHandler) webhookUnavailable(err error) bool {
 func (h *ErrorHandler) HandleReconcilerError(request reconcile.Request, in error) (result reconcile.Result, err error) {
 	resetClient := true
 
+	kind := h.Kind
+	if kind == "" {
+		kind = "unknown"
+	}
+	metrics.ReconcileErrors.WithLabelValues(kind, ErrorClass(in)).Inc()
+	h.lastErrors.Store(request.NamespacedName, in)
+
 	// We use wrapped errors throughout the system so make sure we are looking
 	// at the initial error before determining what actually went wrong.
 	cause := perrors.Cause(in)
@@ -455,139 +541,6 @@ func (in *EventLogger) WarningEvent(object runtime.Object, reason string, messag
 	in.event(object, v1.EventTypeWarning, 1, reason, messageFmt, args...)
 }
 
-/*
-	CollectDiffValues collects and returns the diff values from the given diff string.
-	The function returns lines starting with '+' or '-' that represent the differences,
-	and will provide the parent hierarchy for that line based on the given parameters.
-
-	Output example:
-
-storage:
-
-	"filesystems":
-
--		"size":		5,
-+		"size":		10,
-*/
-func collectDiffValues(diff string, parameters map[string]interface{}) string {
-	var diffLines []string
-	lines := strings.Split(diff, "\n")
-	for _, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
-		line = strings.Join(strings.Fields(trimmedLine), "\t\t")
-		processedLine := removeDataTypes(line)
-		diffLines = append(diffLines, processedLine)
-	}
-
-	delta := processLines(diffLines, parameters)
-	return delta.String()
-}
-
-/*
-removeDataTypes removes data types and specific interfaces from the given string.
-The modified string with data types and specific interfaces removed.
-Example:
-
-	input: "float64(1500)"
-	output: "1500"
-*/
-func removeDataTypes(line string) string {
-	// Define the regular expression to match and capture data types
-	re := regexp.MustCompile(`\b(string|float64|bool|int|)\(([^)]*)\)`)
-	noDataTypes := re.ReplaceAllString(line, "$2")
-
-	// Define the regular expression to match and remove specific interfaces
-	re = regexp.MustCompile(`(map\[string\]interface\{\}|\[\]interface\{\})`)
-	noInterfaces := re.ReplaceAllString(noDataTypes, "$2")
-	return noInterfaces
-}
-
-// processLines processes the diff lines and generates the delta configuration.
-func processLines(lines []string, parameters map[string]interface{}) strings.Builder {
-	var delta strings.Builder
-	lastParent := "-"
-	for i, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
-		// Check if the line starts with a "+" or "-" indicating an added or removed configuration.
-		if strings.HasPrefix(trimmedLine, "+") || strings.HasPrefix(trimmedLine, "-") {
-			// Search for the parent and sub-parameters of the line in the given properties.
-			parent := searchParameters(lines, i, parameters)
-			if parent == ParentFound {
-				// If the line represents a parameter itself, add it directly to the delta.
-				trimmedLine := strings.TrimSpace(trimmedLine)
-				line = strings.Join(strings.Fields(trimmedLine), "  ")
-				delta.WriteString(line)
-				delta.WriteString("\n")
-				continue
-			}
-			// If the parent has changed, add a newline and the parent to the delta.
-			if parent != lastParent {
-				delta.WriteString("\n")
-				delta.WriteString(parent)
-				lastParent = parent
-			}
-			// Add the line to the delta.
-			delta.WriteString(trimmedLine)
-			delta.WriteString("\n")
-		}
-	}
-
-	return delta
-}
-
-// searchParameters searches for the parent and sub-parameters of a given line in the given resource properties.
-// It iterates over the lines starting from the given line number and goes upwards to find the relevant information.
-// Parameters:
-//   - lines: A slice of strings representing the lines of the diff.
-//   - lineNumber: The index of the line being processed.
-//   - parameters: A map of resource properties with their corresponding values.
-//
-// Returns:
-//   - A string representing the hierarchy of the parent and sub-parameters, or "param_found" if the line represents a parameter itself.
-//     The hierarchy is constructed in the format: "parent:\n\t"sub-parameter":\n".
-func searchParameters(lines []string, lineNumber int, parameters map[string]interface{}) string {
-	var result string
-
-	for i := lineNumber; i >= 0; i-- {
-		line := lines[i]
-
-		// Check if the line matches any parameter or sub-parameter in the system properties.
-		for param, subParams := range parameters {
-			if subParams != nil {
-				subParamsList, ok := subParams.([]string)
-				if ok {
-					for _, subParam := range subParamsList {
-						// If a sub-parameter is found in the line, construct the corresponding hierarchy.
-						if strings.Contains(line, subParam) {
-							if i == lineNumber {
-								// If the line represents the sub-parameter itself, return the parent parameter.
-								return fmt.Sprintf("%s:\n", param)
-							}
-							// Append the sub-parameter to the hierarchy.
-							result += fmt.Sprintf("%s:\n\t\"%s\":\n", param, subParam)
-							return result
-						}
-					}
-				}
-			}
-		}
-
-		// Check if the line matches any parameter in the system properties.
-		for param := range parameters {
-			if strings.Contains(line, param) {
-				if i == lineNumber {
-					// If the line represents the parameter itself, indicate it with "param_found".
-					return ParentFound
-				}
-				// Return the parent parameter.
-				return fmt.Sprintf("%s:\n", param)
-			}
-		}
-	}
-
-	return result
-}
-
 // UpdateDefaultRequired checks if the host default need to be updated.
 func UpdateDefaultsRequired(
 	manager manager.CloudManager,
@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	perrors "github.com/pkg/errors"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	v1 "k8s.io/api/core/v1"
@@ -110,93 +111,20 @@ var _ = Describe("Common utils", func() {
 		})
 	})
 
-	Describe("Test removeDataTypes function", func() {
-		Context("when the constant of dataType float64 is given", func() {
-			It("should return the constant without dataType", func() {
-				input1 := "float64(1500)"
-				expected1 := "1500"
-				out1 := removeDataTypes(input1)
-				Expect(out1).To(Equal(expected1))
-			})
+	Describe("Check return for ErrorClass", func() {
+		It("should classify the common error types", func() {
+			Expect(ErrorClass(ErrResourceStatusDependency{BaseError{"status"}})).To(Equal(ErrorClassStatusDependency))
+			Expect(ErrorClass(ErrSystemDependency{BaseError{"system"}})).To(Equal(ErrorClassDependency))
+			Expect(ErrorClass(ErrMissingKubernetesResource{BaseError{"missing"}})).To(Equal(ErrorClassUserData))
+			Expect(ErrorClass(NewValidationError("invalid"))).To(Equal(ErrorClassValidation))
+			Expect(ErrorClass(manager.NewWaitForMonitor("waiting"))).To(Equal(ErrorClassWaitForMonitor))
 		})
-	})
-
-	Describe("Test searchParameters", func() {
-		Context("when the non empty lines are given", func() {
-			It("should expect result as follows", func() {
-
-				// Define test input data
-				lines := []string{
-					"line1",
-					"line2",
-					"line3",
-					"subparam1",
-					"param1",
-					"subparam2",
-					"line5",
-					"line6",
-				}
-
-				parameters := map[string]interface{}{
-					"param1": []string{"subparam1", "subparam2"},
-					"param2": nil,
-				}
-
-				lineNumber := 4 // Index of "param1" in the lines slice
-				// Test case: Parent found
-				expected := "parent_found"
-				result := searchParameters(lines, lineNumber, parameters)
-				Expect(expected).To(Equal(result))
-
-				// Test case: Sub-parameter found
-				lineNumber = 3 // Index of line containing "subparam1"
-				expected = "param1:\n"
-				result = searchParameters(lines, lineNumber, parameters)
-				Expect(expected).To(Equal(result))
-
-				// Test case: Sub-parameter found in above lines
-				lineNumber = 6 // Index of line containing "subparam1"
-				expected = "param1:\n\t\"subparam2\":\n"
-				result = searchParameters(lines, lineNumber, parameters)
-				Expect(expected).To(Equal(result))
-
-				// Test case: Parameter not found
-				lineNumber = 0 // Index of first line
-				expected = ""
-				result = searchParameters(lines, lineNumber, parameters)
-				Expect(expected).To(Equal(result))
-			})
+		It("should classify wrapped errors by their cause", func() {
+			testError := perrors.Wrap(ErrUnlockError{BaseError{"unlock"}}, "failed to unlock")
+			Expect(ErrorClass(testError)).To(Equal(ErrorClassUnlock))
 		})
-	})
-
-	Describe("Test processLines", func() {
-		Context("when there are non empty lines given", func() {
-			It("should gather delta config", func() {
-				lines := []string{"line1", "line2", "line3"}
-				parameters := map[string]interface{}{}
-				expectedResult := ""
-
-				result := processLines(lines, parameters)
-				Expect(expectedResult).To(Equal(result.String()))
-
-				// Define test input data
-				lines = []string{
-					"+ line1",
-					"- line2",
-					"param1",
-					"+ line3",
-					"- line4",
-				}
-				parameters = map[string]interface{}{
-					"param1": nil,
-					"param2": []string{"subparam1", "subparam2"},
-				}
-
-				expectedResult = "\n+ line1\n- line2\n\nparam1:\n+ line3\n- line4\n"
-				result = processLines(lines, parameters)
-				Expect(expectedResult).To(Equal(result.String()))
-
-			})
+		It("should classify unknown errors as unhandled", func() {
+			Expect(ErrorClass(errpkg.New("error msg"))).To(Equal(ErrorClassUnhandled))
 		})
 	})
 })
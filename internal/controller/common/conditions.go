@@ -0,0 +1,399 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// MaxConditionMessageLength is the maximum length of the error message copied
+// into a condition.  Longer messages are truncated.
+const MaxConditionMessageLength = 1024
+
+// ConditionsInstance defines the interface implemented by all resources that
+// report the standard conditions.
+type ConditionsInstance interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// syncInstance defines the interface implemented by resources that track
+// whether their configuration matches the system.
+type syncInstance interface {
+	GetReconciled() bool
+	GetInsync() bool
+}
+
+// strategyInstance defines the interface implemented by resources that track
+// whether an update strategy is required.
+type strategyInstance interface {
+	GetStrategyRequired() string
+}
+
+// waitingErrorClasses are the error classes which indicate that a reconciler
+// is blocked waiting for another resource or a system state change.
+var waitingErrorClasses = map[string]bool{
+	ErrorClassDependency:       true,
+	ErrorClassStatusDependency: true,
+	ErrorClassWaitForMonitor:   true,
+	ErrorClassHostNotify:       true,
+	ErrorClassPlatformNetwork:  true,
+	ErrorClassNotFound:         true,
+}
+
+// userErrorClasses are the error classes which cannot be resolved without a
+// change from the user therefore the reconciler is no longer progressing.
+var userErrorClasses = map[string]bool{
+	ErrorClassAuthentication:       true,
+	ErrorClassUser:                 true,
+	ErrorClassUserData:             true,
+	ErrorClassValidation:           true,
+	ErrorClassChangeAfterReconcile: true,
+}
+
+// camelCase converts a lower case identifier separated by dashes or
+// underscores to the CamelCase format required for condition reasons.
+func camelCase(value string) string {
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+
+	result := ""
+	for _, w := range words {
+		result += strings.ToUpper(w[:1]) + w[1:]
+	}
+
+	return result
+}
+
+// ErrorReason returns the condition reason associated to a reconciler error.
+// The reason is derived from the error class reported by ErrorClass.
+func ErrorReason(in error) string {
+	return camelCase(ErrorClass(in))
+}
+
+// errorMessage returns the condition message associated to a reconciler
+// error.
+func errorMessage(in error) string {
+	message := extractFaultString(in)
+	if len(message) > MaxConditionMessageLength {
+		message = message[:MaxConditionMessageLength-3] + "..."
+	}
+
+	return message
+}
+
+// setCondition is a utility function to set a single condition.
+func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status bool, reason string, message string) {
+	value := metav1.ConditionFalse
+	if status {
+		value = metav1.ConditionTrue
+	}
+
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             value,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// isMonitorDeadlineExceeded determines whether the Degraded condition was set
+// by a monitor which exceeded its deadline.
+func isMonitorDeadlineExceeded(conditions []metav1.Condition) bool {
+	c := meta.FindStatusCondition(conditions, starlingxv1.ConditionDegraded)
+	return c != nil && c.Status == metav1.ConditionTrue && c.Reason == starlingxv1.ReasonMonitorDeadlineExceeded
+}
+
+// UpdateConditions updates the standard conditions of a resource based on
+// its current status and the error returned by the last reconcile attempt.
+// It returns true if the conditions have changed.
+func UpdateConditions(instance ConditionsInstance, in error) bool {
+	return updateStandardConditions(instance, in, false)
+}
+
+// updateStandardConditions implements UpdateConditions.  In the drift detection
+// mode, an ignored change and a reconciled resource being out of sync are only
+// reported through the Drifted condition therefore they do not affect the
+// Degraded and Ready conditions.
+func updateStandardConditions(instance ConditionsInstance, in error, detect bool) bool {
+	if detect && in != nil && ErrorClass(in) == ErrorClassChangeAfterReconcile {
+		in = nil
+	}
+
+	existing := instance.GetConditions()
+	conditions := make([]metav1.Condition, len(existing))
+	copy(conditions, existing)
+
+	generation := instance.GetGeneration()
+
+	class, reason, message := "", "", ""
+	if in != nil {
+		class = ErrorClass(in)
+		reason = ErrorReason(in)
+		message = errorMessage(in)
+	}
+
+	waiting := in != nil && waitingErrorClasses[class]
+	degraded := in != nil && !waiting
+
+	if waiting {
+		setCondition(&conditions, generation, starlingxv1.ConditionWaitingForDependency, true, reason, message)
+	} else {
+		setCondition(&conditions, generation, starlingxv1.ConditionWaitingForDependency, false, starlingxv1.ReasonDependenciesReady, "")
+	}
+
+	// A monitor which exceeded its deadline may have been restarted by the
+	// reconciler; keep reporting the resource as degraded until it completes.
+	expired := class == ErrorClassWaitForMonitor && isMonitorDeadlineExceeded(existing)
+
+	if degraded {
+		setCondition(&conditions, generation, starlingxv1.ConditionDegraded, true, reason, message)
+	} else if !expired {
+		setCondition(&conditions, generation, starlingxv1.ConditionDegraded, false, starlingxv1.ReasonNoErrors, "")
+	}
+
+	if in != nil && !userErrorClasses[class] {
+		setCondition(&conditions, generation, starlingxv1.ConditionReconciling, true, reason, message)
+	} else {
+		setCondition(&conditions, generation, starlingxv1.ConditionReconciling, false, starlingxv1.ReasonReconcileComplete, "")
+	}
+
+	ready, readyReason, readyMessage := in == nil, reason, message
+
+	if obj, ok := instance.(syncInstance); ok {
+		if obj.GetInsync() {
+			setCondition(&conditions, generation, starlingxv1.ConditionInSync, true, starlingxv1.ReasonInSync, "")
+		} else {
+			setCondition(&conditions, generation, starlingxv1.ConditionInSync, false, starlingxv1.ReasonOutOfSync,
+				"the configuration of the system does not match the desired configuration")
+		}
+
+		if ready && !obj.GetReconciled() {
+			ready, readyReason, readyMessage = false, starlingxv1.ReasonNotReconciled, "the resource has not been reconciled yet"
+		} else if ready && !obj.GetInsync() && !detect {
+			ready, readyReason, readyMessage = false, starlingxv1.ReasonOutOfSync, "the resource is not in sync"
+		}
+	}
+
+	if obj, ok := instance.(strategyInstance); ok {
+		strategy := obj.GetStrategyRequired()
+		if strategy != "" && strategy != manager.StrategyNotRequired {
+			setCondition(&conditions, generation, starlingxv1.ConditionStrategyRequired, true, camelCase(strategy), "")
+		} else if strategy != "" {
+			setCondition(&conditions, generation, starlingxv1.ConditionStrategyRequired, false, starlingxv1.ReasonStrategyNotRequired, "")
+		}
+	}
+
+	if ready {
+		setCondition(&conditions, generation, starlingxv1.ConditionReady, true, starlingxv1.ReasonReconciled, "")
+	} else {
+		setCondition(&conditions, generation, starlingxv1.ConditionReady, false, readyReason, readyMessage)
+	}
+
+	if equality.Semantic.DeepEqual(existing, conditions) {
+		return false
+	}
+
+	instance.SetConditions(conditions)
+
+	return true
+}
+
+// conditionsReconciler wraps a reconciler so that the standard conditions of
+// the reconciled resource are refreshed after each reconcile attempt.
+type conditionsReconciler struct {
+	reconcile.Reconciler
+	client  client.Client
+	handler *ErrorHandler
+}
+
+// NewConditionsReconciler returns a reconciler which updates the conditions of
+// the resource after handing off to the actual reconciler.  The error handler
+// supplies the resource kind and the errors that it has handled on behalf of
+// the reconciler.
+func NewConditionsReconciler(c client.Client, handler *ErrorHandler, r reconcile.Reconciler) reconcile.Reconciler {
+	return &conditionsReconciler{Reconciler: r, client: c, handler: handler}
+}
+
+// Reconcile runs the wrapped reconciler and then updates the conditions of the
+// resource.  The wrapped reconciler is not run while the resource is paused.
+// If the wrapped reconciler is in the drift detection mode, the reconciled
+// resource is requeued periodically so that drift is detected.
+func (r *conditionsReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	r.handler.lastErrors.Delete(request.NamespacedName)
+
+	instance, err := r.get(ctx, request)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if instance != nil {
+		paused, reason, err := manager.IsPaused(r.client, instance)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		err = r.updatePaused(ctx, instance, paused, reason)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if paused {
+			// Stop any monitor so that nothing acts on the resource until it
+			// is resumed.  Removing the annotation triggers a new reconcile.
+			r.handler.CancelMonitor(instance)
+			r.handler.V(2).Info("reconcile skipped while paused", "request", request, "reason", reason)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	result, err := r.Reconciler.Reconcile(ctx, request)
+
+	cause := err
+	if value, ok := r.handler.lastErrors.LoadAndDelete(request.NamespacedName); ok && cause == nil {
+		cause = value.(error)
+	}
+
+	detector, detect := r.Reconciler.(DriftDetector)
+	detect = detect && detector.SyncMode() == utils.SyncModeDetect
+
+	instance, err2 := r.updateConditions(ctx, request, cause, detect)
+	if err2 != nil {
+		r.handler.V(2).Info("failed to update conditions", "request", request, "error", err2.Error())
+	}
+
+	if detect && err == nil && instance != nil {
+		if obj, ok := instance.(syncInstance); ok && obj.GetReconciled() {
+			result = driftResult(result, cause, detector.DriftInterval())
+		}
+	}
+
+	return result, err
+}
+
+// get reads the latest version of the resource.  It returns nil if the
+// resource does not exist or does not report conditions.
+func (r *conditionsReconciler) get(ctx context.Context, request reconcile.Request) (ConditionsInstance, error) {
+	obj, err := r.client.Scheme().New(starlingxv1.GroupVersion.WithKind(r.handler.Kind))
+	if err != nil {
+		return nil, err
+	}
+
+	instance, ok := obj.(ConditionsInstance)
+	if !ok {
+		return nil, nil
+	}
+
+	err = r.client.Get(ctx, request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// updatePaused updates the Paused condition of a resource.  A change to the
+// Paused condition of the System resource applies to the whole namespace
+// therefore the other resources are notified so that they report it too.
+func (r *conditionsReconciler) updatePaused(ctx context.Context, instance ConditionsInstance, paused bool, reason string) error {
+	if !paused {
+		reason = starlingxv1.ReasonNotPaused
+	}
+
+	existing := meta.FindStatusCondition(instance.GetConditions(), starlingxv1.ConditionPaused)
+	if existing != nil && existing.Reason == reason {
+		return nil
+	}
+
+	original, ok := instance.DeepCopyObject().(ConditionsInstance)
+	if !ok {
+		return nil
+	}
+
+	conditions := make([]metav1.Condition, len(instance.GetConditions()))
+	copy(conditions, instance.GetConditions())
+	setCondition(&conditions, instance.GetGeneration(), starlingxv1.ConditionPaused, paused, reason, "")
+	instance.SetConditions(conditions)
+
+	err := r.client.Status().Patch(ctx, instance, client.MergeFrom(original))
+	if err != nil {
+		return err
+	}
+
+	if _, ok := instance.(*starlingxv1.System); ok && (paused || existing != nil) {
+		return r.handler.NotifySystemDependencies(instance.GetNamespace())
+	}
+
+	return nil
+}
+
+// updateConditions reads the latest version of the resource and updates its
+// conditions if required.  The Drifted condition is only reported if detect
+// is true.  It returns the updated resource unless it does not exist or is
+// being deleted.
+func (r *conditionsReconciler) updateConditions(ctx context.Context, request reconcile.Request, in error, detect bool) (ConditionsInstance, error) {
+	instance, err := r.get(ctx, request)
+	if err != nil || instance == nil {
+		return nil, err
+	}
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+
+	original, ok := instance.DeepCopyObject().(ConditionsInstance)
+	if !ok {
+		return nil, nil
+	}
+
+	changed := updateStandardConditions(instance, in, detect)
+	drift, detected := UpdateDrift(instance, detect)
+	if !changed && !drift {
+		return instance, nil
+	}
+
+	// Use a merge patch so that only the conditions are written and a
+	// concurrent status update does not cause a conflict.
+	err = r.client.Status().Patch(ctx, instance, client.MergeFrom(original))
+	if err != nil {
+		return instance, err
+	}
+
+	if detected {
+		r.driftDetected(instance)
+	}
+
+	return instance, nil
+}
+
+// driftDetected records that the configuration of the system was found to
+// have drifted from the desired configuration of a resource.
+func (r *conditionsReconciler) driftDetected(instance ConditionsInstance) {
+	metrics.DriftDetected.WithLabelValues(r.handler.Kind).Inc()
+
+	message := driftMessage(instance)
+	if events, ok := r.Reconciler.(ReconcilerEventLogger); ok {
+		events.WarningEvent(instance, ResourceDrifted, "%s", message)
+	} else {
+		r.handler.Info(message, "name", instance.GetName(), "namespace", instance.GetNamespace())
+	}
+}
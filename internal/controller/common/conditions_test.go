@@ -0,0 +1,370 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	errpkg "errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type testReconciler struct {
+	handler *ErrorHandler
+	err     error
+	called  bool
+}
+
+func (r *testReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	r.called = true
+	if r.err != nil {
+		return r.handler.HandleReconcilerError(request, r.err)
+	}
+	return reconcile.Result{}, nil
+}
+
+type testDriftReconciler struct {
+	testReconciler
+	*EventLogger
+	mode utils.SyncMode
+}
+
+func (r *testDriftReconciler) SyncMode() utils.SyncMode {
+	return r.mode
+}
+
+func (r *testDriftReconciler) DriftInterval() time.Duration {
+	return 5 * time.Minute
+}
+
+var _ = Describe("Conditions utils", func() {
+	conditionStatus := func(instance ConditionsInstance, conditionType string) (metav1.ConditionStatus, string) {
+		c := meta.FindStatusCondition(instance.GetConditions(), conditionType)
+		Expect(c).ToNot(BeNil())
+		return c.Status, c.Reason
+	}
+
+	Describe("Check return for ErrorReason", func() {
+		It("should return a CamelCase reason for each error class", func() {
+			Expect(ErrorReason(ErrResourceStatusDependency{BaseError{"status"}})).To(Equal("StatusDependency"))
+			Expect(ErrorReason(NewValidationError("invalid"))).To(Equal("Validation"))
+			Expect(ErrorReason(errpkg.New("error msg"))).To(Equal("Unhandled"))
+		})
+	})
+
+	Describe("Test UpdateConditions", func() {
+		var instance *starlingxv1.DataNetwork
+		BeforeEach(func() {
+			instance = &starlingxv1.DataNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "group0-data0", Namespace: "default", Generation: 3},
+			}
+			instance.Status.Reconciled = true
+			instance.Status.InSync = true
+			instance.Status.StrategyRequired = manager.StrategyNotRequired
+		})
+		Context("when the resource is reconciled and in sync", func() {
+			It("should report Ready", func() {
+				Expect(UpdateConditions(instance, nil)).To(BeTrue())
+
+				status, reason := conditionStatus(instance, starlingxv1.ConditionReady)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				Expect(reason).To(Equal(starlingxv1.ReasonReconciled))
+				status, _ = conditionStatus(instance, starlingxv1.ConditionInSync)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				status, _ = conditionStatus(instance, starlingxv1.ConditionStrategyRequired)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionReady).ObservedGeneration).To(Equal(int64(3)))
+			})
+			It("should report no change on the second update", func() {
+				Expect(UpdateConditions(instance, nil)).To(BeTrue())
+				Expect(UpdateConditions(instance, nil)).To(BeFalse())
+			})
+		})
+		Context("when the resource requires a strategy and is out of sync", func() {
+			It("should report StrategyRequired and not Ready", func() {
+				instance.Status.InSync = false
+				instance.Status.StrategyRequired = manager.StrategyLockRequired
+				UpdateConditions(instance, nil)
+
+				status, reason := conditionStatus(instance, starlingxv1.ConditionStrategyRequired)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				Expect(reason).To(Equal("LockRequired"))
+				status, reason = conditionStatus(instance, starlingxv1.ConditionReady)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+				Expect(reason).To(Equal(starlingxv1.ReasonOutOfSync))
+			})
+		})
+		Context("when the reconciler is waiting for a dependency", func() {
+			It("should report WaitingForDependency and Reconciling", func() {
+				UpdateConditions(instance, ErrResourceStatusDependency{BaseError{"waiting for host"}})
+
+				status, reason := conditionStatus(instance, starlingxv1.ConditionWaitingForDependency)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				Expect(reason).To(Equal("StatusDependency"))
+				status, _ = conditionStatus(instance, starlingxv1.ConditionReconciling)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				status, _ = conditionStatus(instance, starlingxv1.ConditionDegraded)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+				status, reason = conditionStatus(instance, starlingxv1.ConditionReady)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+				Expect(reason).To(Equal("StatusDependency"))
+			})
+		})
+		Context("when the reconciler fails with a validation error", func() {
+			It("should report Degraded and stop Reconciling", func() {
+				UpdateConditions(instance, NewValidationError("invalid MTU"))
+
+				status, reason := conditionStatus(instance, starlingxv1.ConditionDegraded)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				Expect(reason).To(Equal("Validation"))
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, starlingxv1.ConditionDegraded).Message).To(Equal("invalid MTU"))
+				status, _ = conditionStatus(instance, starlingxv1.ConditionReconciling)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+			})
+		})
+		Context("when a monitor has exceeded its deadline", func() {
+			It("should remain Degraded until the reconciler stops waiting", func() {
+				meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+					Type:   starlingxv1.ConditionDegraded,
+					Status: metav1.ConditionTrue,
+					Reason: starlingxv1.ReasonMonitorDeadlineExceeded,
+				})
+
+				UpdateConditions(instance, manager.NewWaitForMonitor("waiting for host"))
+				status, reason := conditionStatus(instance, starlingxv1.ConditionDegraded)
+				Expect(status).To(Equal(metav1.ConditionTrue))
+				Expect(reason).To(Equal(starlingxv1.ReasonMonitorDeadlineExceeded))
+
+				UpdateConditions(instance, nil)
+				status, _ = conditionStatus(instance, starlingxv1.ConditionDegraded)
+				Expect(status).To(Equal(metav1.ConditionFalse))
+			})
+		})
+		Context("when the resource does not track its sync state", func() {
+			It("should only report the error based conditions", func() {
+				profile := &starlingxv1.HostProfile{}
+				UpdateConditions(profile, nil)
+
+				Expect(meta.FindStatusCondition(profile.Status.Conditions, starlingxv1.ConditionInSync)).To(BeNil())
+				Expect(meta.FindStatusCondition(profile.Status.Conditions, starlingxv1.ConditionStrategyRequired)).To(BeNil())
+				Expect(meta.IsStatusConditionTrue(profile.Status.Conditions, starlingxv1.ConditionReady)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Test ReconcileAfterInSyncAllowed", func() {
+		annotations := map[string]string{manager.ReconcileAfterInSync: "true"}
+		It("should honour the annotation unless in the drift detection mode", func() {
+			Expect(ReconcileAfterInSyncAllowed(utils.SyncModeStop, annotations)).To(BeTrue())
+			Expect(ReconcileAfterInSyncAllowed(utils.SyncModeReconcile, annotations)).To(BeTrue())
+			Expect(ReconcileAfterInSyncAllowed(utils.SyncModeStop, nil)).To(BeFalse())
+			Expect(ReconcileAfterInSyncAllowed(utils.SyncModeDetect, annotations)).To(BeFalse())
+		})
+	})
+
+	Describe("Test IgnoreChangeAfterInSync", func() {
+		It("should only generate an event outside of the drift detection mode", func() {
+			events := record.NewFakeRecorder(10)
+			logger := &EventLogger{EventRecorder: events, Logger: logr.Discard()}
+			instance := &starlingxv1.DataNetwork{ObjectMeta: metav1.ObjectMeta{Name: "group0-data0"}}
+
+			err := IgnoreChangeAfterInSync(logger, utils.SyncModeStop, instance, NoChangesAfterReconciled)
+			Expect(ErrorClass(err)).To(Equal(ErrorClassChangeAfterReconcile))
+			Expect(events.Events).To(Receive(ContainSubstring(NoChangesAfterReconciled)))
+
+			err = IgnoreChangeAfterInSync(logger, utils.SyncModeDetect, instance, NoChangesAfterReconciled)
+			Expect(ErrorClass(err)).To(Equal(ErrorClassChangeAfterReconcile))
+			Expect(events.Events).ToNot(Receive())
+		})
+	})
+
+	Describe("Test NewConditionsReconciler", func() {
+		It("should record the error handled by the reconciler in the conditions", func() {
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			instance := &starlingxv1.AddressPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "oam", Namespace: "default"},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+
+			handler := &ErrorHandler{Logger: logr.Discard(), Kind: "AddressPool"}
+			r := NewConditionsReconciler(c, handler, &testReconciler{
+				handler: handler,
+				err:     ErrMissingKubernetesResource{BaseError{"missing secret"}},
+			})
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "oam", Namespace: "default"}}
+			_, err := r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := &starlingxv1.AddressPool{}
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, reason := conditionStatus(updated, starlingxv1.ConditionDegraded)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+			Expect(reason).To(Equal("UserData"))
+			status, _ = conditionStatus(updated, starlingxv1.ConditionPaused)
+			Expect(status).To(Equal(metav1.ConditionFalse))
+		})
+		It("should not run the reconciler while the resource or its system is paused", func() {
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			instance := &starlingxv1.AddressPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "oam",
+					Namespace:   "default",
+					Annotations: map[string]string{manager.PauseKey: "true"},
+				},
+			}
+			system := &starlingxv1.System{
+				ObjectMeta: metav1.ObjectMeta{Name: "system", Namespace: "default"},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance, system).WithStatusSubresource(instance).Build()
+
+			mgr := &manager.Dummymanager{Client: c, MonitorStarted: true}
+			handler := &ErrorHandler{Logger: logr.Discard(), CloudManager: mgr, Kind: "AddressPool"}
+			inner := &testReconciler{handler: handler}
+			r := NewConditionsReconciler(c, handler, inner)
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "oam", Namespace: "default"}}
+			_, err := r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.called).To(BeFalse())
+			Expect(mgr.MonitorStarted).To(BeFalse())
+
+			updated := &starlingxv1.AddressPool{}
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, reason := conditionStatus(updated, starlingxv1.ConditionPaused)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+			Expect(reason).To(Equal(starlingxv1.ReasonPauseRequested))
+
+			updated.Annotations = nil
+			Expect(c.Update(context.TODO(), updated)).To(Succeed())
+			system.Annotations = map[string]string{manager.PauseKey: "true"}
+			Expect(c.Update(context.TODO(), system)).To(Succeed())
+
+			_, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.called).To(BeFalse())
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			_, reason = conditionStatus(updated, starlingxv1.ConditionPaused)
+			Expect(reason).To(Equal(starlingxv1.ReasonSystemPaused))
+
+			system.Annotations = nil
+			Expect(c.Update(context.TODO(), system)).To(Succeed())
+
+			_, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.called).To(BeTrue())
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, reason = conditionStatus(updated, starlingxv1.ConditionPaused)
+			Expect(status).To(Equal(metav1.ConditionFalse))
+			Expect(reason).To(Equal(starlingxv1.ReasonNotPaused))
+		})
+		It("should report drift and requeue in the drift detection mode", func() {
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			instance := &starlingxv1.PtpInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "ptp1", Namespace: "default"},
+				Status:     starlingxv1.PtpInstanceStatus{Reconciled: true, InSync: false},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+
+			events := record.NewFakeRecorder(10)
+			handler := &ErrorHandler{Logger: logr.Discard(), Kind: "PtpInstance"}
+			inner := &testDriftReconciler{
+				testReconciler: testReconciler{handler: handler},
+				EventLogger:    &EventLogger{EventRecorder: events, Logger: logr.Discard()},
+				mode:           utils.SyncModeDetect,
+			}
+			r := NewConditionsReconciler(c, handler, inner)
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "ptp1", Namespace: "default"}}
+			result, err := r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+			Expect(events.Events).To(Receive(ContainSubstring(ResourceDrifted)))
+
+			updated := &starlingxv1.PtpInstance{}
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, reason := conditionStatus(updated, starlingxv1.ConditionDrifted)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+			Expect(reason).To(Equal(starlingxv1.ReasonDriftDetected))
+
+			_, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events.Events).ToNot(Receive())
+
+			updated.Status.InSync = true
+			Expect(c.Status().Update(context.TODO(), updated)).To(Succeed())
+			_, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, reason = conditionStatus(updated, starlingxv1.ConditionDrifted)
+			Expect(status).To(Equal(metav1.ConditionFalse))
+			Expect(reason).To(Equal(starlingxv1.ReasonNoDrift))
+
+			inner.mode = utils.SyncModeStop
+			result, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			Expect(meta.FindStatusCondition(updated.Status.Conditions, starlingxv1.ConditionDrifted)).To(BeNil())
+		})
+		It("should only report an ignored change as drift in the drift detection mode", func() {
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			instance := &starlingxv1.PtpInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "ptp1", Namespace: "default"},
+				Status:     starlingxv1.PtpInstanceStatus{Reconciled: true, InSync: false},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+
+			handler := &ErrorHandler{Logger: logr.Discard(), Kind: "PtpInstance"}
+			inner := &testDriftReconciler{
+				testReconciler: testReconciler{handler: handler, err: NewChangeAfterInSync(NoChangesAfterReconciled)},
+				EventLogger:    &EventLogger{EventRecorder: record.NewFakeRecorder(10), Logger: logr.Discard()},
+				mode:           utils.SyncModeDetect,
+			}
+			r := NewConditionsReconciler(c, handler, inner)
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "ptp1", Namespace: "default"}}
+			result, err := r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+
+			updated := &starlingxv1.PtpInstance{}
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, _ := conditionStatus(updated, starlingxv1.ConditionDrifted)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+			status, _ = conditionStatus(updated, starlingxv1.ConditionDegraded)
+			Expect(status).To(Equal(metav1.ConditionFalse))
+			status, _ = conditionStatus(updated, starlingxv1.ConditionReady)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+
+			inner.mode = utils.SyncModeStop
+			_, err = r.Reconcile(context.TODO(), request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(context.TODO(), request.NamespacedName, updated)).To(Succeed())
+			status, _ = conditionStatus(updated, starlingxv1.ConditionDegraded)
+			Expect(status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+})
@@ -1,55 +1,353 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
 package common
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
-	"github.com/google/go-cmp/cmp"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// MaxDeltaEntries is the maximum number of differences stored in the
+	// status of a resource.  Additional differences are omitted and the
+	// delta is flagged as truncated.
+	MaxDeltaEntries = 100
+
+	// MaxDeltaValueLength is the maximum length of the JSON encoded desired
+	// and observed values of a delta entry.  Longer values are truncated.
+	MaxDeltaValueLength = 256
+
+	// redactedText replaces the value of nested sensitive attributes.
+	redactedText = "***REDACTED***"
+
+	// RedactedValue replaces the JSON encoded value of sensitive attributes.
+	RedactedValue = "\"" + redactedText + "\""
+)
+
+// sensitiveKeys are the attribute name fragments which identify values that
+// must never be copied into the status of a resource.
+var sensitiveKeys = []string{"password", "token", "privatekey", "passphrase"}
+
+// identifyingKeys are the attributes used, in order of preference, to match
+// list elements between the desired and observed configuration.
+var identifyingKeys = []string{"name", "path"}
+
 type instance interface {
 	client.Object
 
 	SetStatusDelta(string)
 	GetStatusDelta() string
+	SetStatusDeltaEntries(entries []starlingxv1.DeltaEntry, truncated bool)
+	GetStatusDeltaEntries() []starlingxv1.DeltaEntry
 	GetInsync() bool
 }
 
-func GetDeltaString(spec interface{}, current interface{}, parameters map[string]interface{}) (string, error) {
+// deltaBuilder accumulates the delta entries while walking the desired and
+// observed configuration.
+type deltaBuilder struct {
+	entries   []starlingxv1.DeltaEntry
+	truncated bool
+}
+
+// isSensitive determines whether an attribute name refers to a sensitive
+// value.
+func isSensitive(key string) bool {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact returns a copy of a value with the nested sensitive attributes
+// replaced.
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			if isSensitive(k) {
+				result[k] = redactedText
+			} else {
+				result[k] = redact(e)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = redact(e)
+		}
+		return result
+	}
+
+	return value
+}
+
+// encodeDeltaValue returns the JSON encoded representation of a value bounded
+// to MaxDeltaValueLength.
+func encodeDeltaValue(value interface{}, sensitive bool) string {
+	if value == nil {
+		return ""
+	}
+
+	if sensitive {
+		return RedactedValue
+	}
+
+	data, err := json.Marshal(redact(value))
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	result := string(data)
+	if len(result) > MaxDeltaValueLength {
+		result = result[:MaxDeltaValueLength-3] + "..."
+	}
+
+	return result
+}
+
+// add records a single difference unless the maximum number of entries has
+// already been reached.
+func (b *deltaBuilder) add(path, op string, desired, observed interface{}, sensitive bool) {
+	if len(b.entries) >= MaxDeltaEntries {
+		b.truncated = true
+		return
+	}
+
+	b.entries = append(b.entries, starlingxv1.DeltaEntry{
+		Path:     path,
+		Op:       op,
+		Desired:  encodeDeltaValue(desired, sensitive),
+		Observed: encodeDeltaValue(observed, sensitive),
+	})
+}
+
+// joinPath appends an attribute name to a dotted path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// walk compares a desired and observed value and records the differences.
+func (b *deltaBuilder) walk(path string, desired, observed interface{}, sensitive bool) {
+	if desired == nil && observed == nil {
+		return
+	} else if observed == nil {
+		b.add(path, starlingxv1.DeltaOpAdd, desired, nil, sensitive)
+		return
+	} else if desired == nil {
+		b.add(path, starlingxv1.DeltaOpRemove, nil, observed, sensitive)
+		return
+	}
+
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		if o, ok := observed.(map[string]interface{}); ok {
+			b.walkMap(path, d, o, sensitive)
+			return
+		}
+	case []interface{}:
+		if o, ok := observed.([]interface{}); ok {
+			b.walkList(path, d, o, sensitive)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(desired, observed) {
+		b.add(path, starlingxv1.DeltaOpReplace, desired, observed, sensitive)
+	}
+}
+
+// walkMap compares the union of the attributes of two objects in a stable
+// order.
+func (b *deltaBuilder) walkMap(path string, desired, observed map[string]interface{}, sensitive bool) {
+	keys := make([]string, 0, len(desired)+len(observed))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		b.walk(joinPath(path, k), desired[k], observed[k], sensitive || isSensitive(k))
+	}
+}
+
+// listKey returns the attribute which identifies every element of both lists
+// or an empty string if the elements must be matched by index.
+func listKey(desired, observed []interface{}) string {
+	for _, key := range identifyingKeys {
+		found := true
+		for _, list := range [][]interface{}{desired, observed} {
+			for _, e := range list {
+				m, ok := e.(map[string]interface{})
+				if !ok {
+					return ""
+				}
+				if _, ok := m[key].(string); !ok {
+					found = false
+					break
+				}
+			}
+		}
+		if found {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// walkList compares two lists.  Lists of objects are compared element by
+// element while lists of scalar values are compared as a whole.
+func (b *deltaBuilder) walkList(path string, desired, observed []interface{}, sensitive bool) {
+	objects := len(desired) > 0 || len(observed) > 0
+	for _, list := range [][]interface{}{desired, observed} {
+		for _, e := range list {
+			if _, ok := e.(map[string]interface{}); !ok {
+				objects = false
+			}
+		}
+	}
+
+	if !objects {
+		if !reflect.DeepEqual(desired, observed) {
+			b.add(path, starlingxv1.DeltaOpReplace, desired, observed, sensitive)
+		}
+		return
+	}
+
+	key := listKey(desired, observed)
+	if key == "" {
+		count := len(desired)
+		if len(observed) > count {
+			count = len(observed)
+		}
+
+		for i := 0; i < count; i++ {
+			var d, o interface{}
+			if i < len(desired) {
+				d = desired[i]
+			}
+			if i < len(observed) {
+				o = observed[i]
+			}
+			b.walk(path+"["+strconv.Itoa(i)+"]", d, o, sensitive)
+		}
+		return
+	}
+
+	observedByKey := make(map[string]interface{}, len(observed))
+	for _, e := range observed {
+		observedByKey[e.(map[string]interface{})[key].(string)] = e
+	}
+
+	desiredByKey := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		id := e.(map[string]interface{})[key].(string)
+		desiredByKey[id] = true
+		b.walk(fmt.Sprintf("%s[%s=%s]", path, key, id), e, observedByKey[id], sensitive)
+	}
 
-	specBytes, err := json.Marshal(spec)
+	for _, e := range observed {
+		id := e.(map[string]interface{})[key].(string)
+		if !desiredByKey[id] {
+			b.walk(fmt.Sprintf("%s[%s=%s]", path, key, id), nil, e, sensitive)
+		}
+	}
+}
+
+// toGeneric converts a structure to its generic JSON representation.
+func toGeneric(in interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(in)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	currentBytes, err := json.Marshal(current)
+	var result map[string]interface{}
+	err = json.Unmarshal(data, &result)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var specData map[string]interface{}
-	var currentData map[string]interface{}
+	return result, nil
+}
 
-	err = json.Unmarshal([]byte(specBytes), &specData)
+// GetDelta returns the list of differences between the desired and the
+// observed configuration.  Only the top level attributes listed in
+// parameters are compared unless parameters is nil.  The returned boolean
+// indicates whether some differences were omitted because of the size limit.
+func GetDelta(spec interface{}, current interface{}, parameters map[string]interface{}) ([]starlingxv1.DeltaEntry, bool, error) {
+	specData, err := toGeneric(spec)
 	if err != nil {
-		return "", err
+		return nil, false, err
 	}
 
-	err = json.Unmarshal([]byte(currentBytes), &currentData)
+	currentData, err := toGeneric(current)
 	if err != nil {
-		return "", err
+		return nil, false, err
+	}
+
+	if parameters != nil {
+		for _, data := range []map[string]interface{}{specData, currentData} {
+			for k := range data {
+				if _, ok := parameters[k]; !ok {
+					delete(data, k)
+				}
+			}
+		}
+	}
+
+	b := &deltaBuilder{}
+	b.walkMap("", specData, currentData, false)
+
+	return b.entries, b.truncated, nil
+}
+
+// RenderDelta returns a human readable rendering of a list of differences.
+// Each difference is reported with the observed value prefixed by "-" and
+// the desired value prefixed by "+".
+func RenderDelta(entries []starlingxv1.DeltaEntry, truncated bool) string {
+	lines := make([]string, 0, len(entries)*2+1)
+	for _, e := range entries {
+		if e.Op != starlingxv1.DeltaOpAdd {
+			lines = append(lines, fmt.Sprintf("- %s: %s", e.Path, e.Observed))
+		}
+		if e.Op != starlingxv1.DeltaOpRemove {
+			lines = append(lines, fmt.Sprintf("+ %s: %s", e.Path, e.Desired))
+		}
+	}
+
+	if truncated {
+		lines = append(lines, "... additional differences omitted")
 	}
 
-	diff := cmp.Diff(currentData, specData)
-	deltaString := collectDiffValues(diff, parameters)
-	deltaString = strings.TrimSuffix(deltaString, "\n")
-	return deltaString, nil
+	return strings.Join(lines, "\n")
 }
 
+// SetInstanceDelta updates the delta reported in the status of a resource
+// based on the differences between the desired and observed configuration.
 func SetInstanceDelta(
 	inst instance, spec, current interface{},
 	parameters map[string]interface{},
@@ -59,15 +357,18 @@ func SetInstanceDelta(
 	log.Info(fmt.Sprintf("Updating delta for kind %s", kind))
 
 	oldDelta := inst.GetStatusDelta()
+	oldEntries := inst.GetStatusDeltaEntries()
 	if inst.GetInsync() {
 		inst.SetStatusDelta("")
-	} else if delta, err := GetDeltaString(spec, current, parameters); err == nil {
-		inst.SetStatusDelta(delta)
+		inst.SetStatusDeltaEntries(nil, false)
+	} else if entries, truncated, err := GetDelta(spec, current, parameters); err == nil {
+		inst.SetStatusDelta(RenderDelta(entries, truncated))
+		inst.SetStatusDeltaEntries(entries, truncated)
 	} else {
-		log.Info(fmt.Sprintf("Failed to get Delta string for kind %s: %s\n", kind, err))
+		log.Info(fmt.Sprintf("Failed to get Delta for kind %s: %s\n", kind, err))
 	}
 
-	if oldDelta != inst.GetStatusDelta() {
+	if oldDelta != inst.GetStatusDelta() || !reflect.DeepEqual(oldEntries, inst.GetStatusDeltaEntries()) {
 		err := status.Update(context.TODO(), inst)
 		if err != nil {
 			log.Info(fmt.Sprintf("Failed to update the status for kind %s: %s", kind, err))
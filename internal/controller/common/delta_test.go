@@ -0,0 +1,106 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("Delta utils", func() {
+	Describe("Test GetDelta", func() {
+		It("should report nothing when the configurations match", func() {
+			spec := map[string]interface{}{"contact": "info@example.com"}
+			entries, truncated, err := GetDelta(spec, spec, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+			Expect(truncated).To(BeFalse())
+		})
+		It("should match nested list elements by name", func() {
+			spec := map[string]interface{}{
+				"interfaces": map[string]interface{}{
+					"ethernet": []interface{}{
+						map[string]interface{}{"name": "oam0", "mtu": 1500},
+						map[string]interface{}{"name": "data0", "mtu": 9000},
+					},
+				},
+			}
+			current := map[string]interface{}{
+				"interfaces": map[string]interface{}{
+					"ethernet": []interface{}{
+						map[string]interface{}{"name": "data0", "mtu": 1500},
+						map[string]interface{}{"name": "mgmt0", "mtu": 1500},
+						map[string]interface{}{"name": "oam0", "mtu": 1500},
+					},
+				},
+			}
+			entries, _, err := GetDelta(spec, current, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]starlingxv1.DeltaEntry{
+				{Path: "interfaces.ethernet[name=data0].mtu", Op: starlingxv1.DeltaOpReplace, Desired: "9000", Observed: "1500"},
+				{Path: "interfaces.ethernet[name=mgmt0]", Op: starlingxv1.DeltaOpRemove, Observed: `{"mtu":1500,"name":"mgmt0"}`},
+			}))
+		})
+		It("should compare scalar lists as a whole and only the listed parameters", func() {
+			spec := map[string]interface{}{"dnsServers": []string{"8.8.8.8"}, "ignored": "a"}
+			current := map[string]interface{}{"dnsServers": []string{"8.8.4.4", "8.8.8.8"}, "ignored": "b"}
+			entries, _, err := GetDelta(spec, current, map[string]interface{}{"dnsServers": nil})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]starlingxv1.DeltaEntry{
+				{Path: "dnsServers", Op: starlingxv1.DeltaOpReplace, Desired: `["8.8.8.8"]`, Observed: `["8.8.4.4","8.8.8.8"]`},
+			}))
+		})
+		It("should redact sensitive values", func() {
+			spec := map[string]interface{}{
+				"boardManagement": map[string]interface{}{
+					"credentials": map[string]interface{}{"password": "s3cret", "username": "admin"},
+				},
+			}
+			current := map[string]interface{}{}
+			entries, _, err := GetDelta(spec, current, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Op).To(Equal(starlingxv1.DeltaOpAdd))
+			Expect(entries[0].Desired).ToNot(ContainSubstring("s3cret"))
+			Expect(entries[0].Desired).To(ContainSubstring("admin"))
+
+			spec = map[string]interface{}{"credentials": map[string]interface{}{"password": "new", "username": "admin"}}
+			current = map[string]interface{}{"credentials": map[string]interface{}{"password": "old", "username": "root"}}
+			entries, _, err = GetDelta(spec, current, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]starlingxv1.DeltaEntry{
+				{Path: "credentials.password", Op: starlingxv1.DeltaOpReplace, Desired: RedactedValue, Observed: RedactedValue},
+				{Path: "credentials.username", Op: starlingxv1.DeltaOpReplace, Desired: `"admin"`, Observed: `"root"`},
+			}))
+		})
+		It("should bound the number and size of the entries", func() {
+			spec := map[string]interface{}{}
+			for i := 0; i < MaxDeltaEntries+10; i++ {
+				spec[fmt.Sprintf("key%03d", i)] = i
+			}
+			spec["key000"] = fmt.Sprintf("%0300d", 0)
+			entries, truncated, err := GetDelta(spec, map[string]interface{}{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(MaxDeltaEntries))
+			Expect(truncated).To(BeTrue())
+			Expect(entries[0].Desired).To(HaveLen(MaxDeltaValueLength))
+		})
+	})
+
+	Describe("Test RenderDelta", func() {
+		It("should render each operation", func() {
+			entries := []starlingxv1.DeltaEntry{
+				{Path: "mtu", Op: starlingxv1.DeltaOpReplace, Desired: "9000", Observed: "1500"},
+				{Path: "location", Op: starlingxv1.DeltaOpAdd, Desired: `"lab"`},
+				{Path: "contact", Op: starlingxv1.DeltaOpRemove, Observed: `"admin"`},
+			}
+			Expect(RenderDelta(entries, true)).To(Equal(
+				"- mtu: 1500\n+ mtu: 9000\n+ location: \"lab\"\n- contact: \"admin\"\n... additional differences omitted"))
+			Expect(RenderDelta(nil, false)).To(Equal(""))
+		})
+	})
+})
@@ -0,0 +1,159 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// MaxDriftMessagePaths is the maximum number of attribute paths listed in
+// the message of the Drifted condition.
+const MaxDriftMessagePaths = 5
+
+// DriftDetector defines the interface implemented by reconcilers which
+// support the drift detection mode.  A reconciler in that mode behaves as if
+// it was configured to stop after being in sync, therefore it never modifies
+// the system once the resource has been reconciled, but it is run
+// periodically so that the delta against the desired configuration is
+// refreshed.
+type DriftDetector interface {
+	SyncMode() utils.SyncMode
+	DriftInterval() time.Duration
+}
+
+// ReconcileAfterInSyncAllowed determines whether changes may still be applied
+// to a resource which has already been reconciled.  The ReconcileAfterInSync
+// annotation set on resources in the principal scope is ignored in the drift
+// detection mode so that the system is never modified once reconciled.
+func ReconcileAfterInSyncAllowed(mode utils.SyncMode, annotations map[string]string) bool {
+	if mode == utils.SyncModeDetect {
+		return false
+	}
+
+	_, present := annotations[manager.ReconcileAfterInSync]
+	return present
+}
+
+// IgnoreChangeAfterInSync returns the error used to report that a change to a
+// resource which has already been reconciled is ignored.  An event is only
+// generated outside of the drift detection mode since the change is otherwise
+// reported through the Drifted condition.
+func IgnoreChangeAfterInSync(events ReconcilerEventLogger, mode utils.SyncMode, object runtime.Object, msg string) error {
+	if mode != utils.SyncModeDetect {
+		events.NormalEvent(object, ResourceUpdated, msg)
+	}
+
+	return NewChangeAfterInSync(msg)
+}
+
+// deltaEntriesInstance defines the interface implemented by resources which
+// report the individual differences with the system.
+type deltaEntriesInstance interface {
+	GetStatusDeltaEntries() []starlingxv1.DeltaEntry
+}
+
+// IsDrifted determines whether the configuration of the system no longer
+// matches the desired configuration of a resource which has already been
+// reconciled.
+func IsDrifted(instance ConditionsInstance) bool {
+	obj, ok := instance.(syncInstance)
+	return ok && obj.GetReconciled() && !obj.GetInsync()
+}
+
+// driftMessage returns the message of the Drifted condition.  It lists the
+// first few attributes which differ when the resource reports them.
+func driftMessage(instance ConditionsInstance) string {
+	message := "the configuration of the system has drifted from the desired configuration"
+
+	obj, ok := instance.(deltaEntriesInstance)
+	if !ok || len(obj.GetStatusDeltaEntries()) == 0 {
+		return message
+	}
+
+	entries := obj.GetStatusDeltaEntries()
+	paths := make([]string, 0, MaxDriftMessagePaths)
+	for i := 0; i < len(entries) && i < MaxDriftMessagePaths; i++ {
+		paths = append(paths, entries[i].Path)
+	}
+
+	result := fmt.Sprintf("%s: %s", message, strings.Join(paths, ", "))
+	if len(entries) > MaxDriftMessagePaths {
+		result += fmt.Sprintf(" and %d more", len(entries)-MaxDriftMessagePaths)
+	}
+
+	return result
+}
+
+// UpdateDrift updates the Drifted condition of a resource.  The condition is
+// removed if detect is false so that it does not linger once the drift
+// detection mode is disabled.  It returns true if the conditions have changed
+// and whether the resource has just been found to have drifted.
+func UpdateDrift(instance ConditionsInstance, detect bool) (changed bool, detected bool) {
+	existing := instance.GetConditions()
+	previous := meta.FindStatusCondition(existing, starlingxv1.ConditionDrifted)
+
+	conditions := make([]metav1.Condition, len(existing))
+	copy(conditions, existing)
+
+	if !detect {
+		if previous == nil {
+			return false, false
+		}
+
+		meta.RemoveStatusCondition(&conditions, starlingxv1.ConditionDrifted)
+		instance.SetConditions(conditions)
+
+		return true, false
+	}
+
+	drifted := IsDrifted(instance)
+	if drifted {
+		setCondition(&conditions, instance.GetGeneration(), starlingxv1.ConditionDrifted, true,
+			starlingxv1.ReasonDriftDetected, driftMessage(instance))
+	} else {
+		setCondition(&conditions, instance.GetGeneration(), starlingxv1.ConditionDrifted, false,
+			starlingxv1.ReasonNoDrift, "")
+	}
+
+	detected = drifted && (previous == nil || previous.Status != metav1.ConditionTrue)
+
+	updated := meta.FindStatusCondition(conditions, starlingxv1.ConditionDrifted)
+	if previous != nil && previous.Status == updated.Status && previous.Reason == updated.Reason &&
+		previous.Message == updated.Message && previous.ObservedGeneration == updated.ObservedGeneration {
+		return false, detected
+	}
+
+	instance.SetConditions(conditions)
+
+	return true, detected
+}
+
+// driftResult returns the result of a reconcile attempt adjusted so that a
+// reconciled resource is checked again for drift once the drift interval has
+// passed.  Results which already schedule a retry, and resources which are
+// waiting on a monitor, are left unchanged since they will be checked again
+// anyway.
+func driftResult(result reconcile.Result, in error, interval time.Duration) reconcile.Result {
+	if result.Requeue || result.RequeueAfter > 0 || interval <= 0 {
+		return result
+	}
+
+	if in != nil && ErrorClass(in) == ErrorClassWaitForMonitor {
+		return result
+	}
+
+	result.RequeueAfter = interval
+
+	return result
+}
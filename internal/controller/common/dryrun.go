@@ -0,0 +1,517 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	perrors "github.com/pkg/errors"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// MaxPlannedRequests is the maximum number of requests stored in the plan
+	// of a resource.  Additional requests are omitted and the plan is flagged
+	// as truncated.
+	MaxPlannedRequests = 100
+
+	// MaxPlannedBodyLength is the maximum length of the JSON encoded body of a
+	// planned request.  Longer bodies are truncated.
+	MaxPlannedBodyLength = 1024
+
+	// dryRunDeferredDeletion is reported in the plan of a resource which is
+	// being deleted while in dry-run mode.
+	dryRunDeferredDeletion = "deletion is deferred until dry-run mode is disabled"
+)
+
+// dryRunNoContentSuffixes are the path suffixes of the POST requests for which
+// the system API returns no content.
+var dryRunNoContentSuffixes = []string{"/apply"}
+
+// DryRunInstance defines the interface implemented by resources which can be
+// reconciled in dry-run mode.
+type DryRunInstance interface {
+	client.Object
+	GetStatusPlan() *starlingxv1.PlanStatus
+	SetStatusPlan(plan *starlingxv1.PlanStatus)
+}
+
+// IsDryRunEnabled determines whether a resource must be reconciled in dry-run
+// mode.  Dry-run mode is enabled by setting the DryRunKey annotation to "true"
+// either on the resource itself or on the System resource of its namespace.
+func IsDryRunEnabled(c client.Reader, instance client.Object) (bool, error) {
+	if isDryRunAnnotated(instance) {
+		return true, nil
+	}
+
+	if _, ok := instance.(*starlingxv1.System); ok {
+		return false, nil
+	}
+
+	systems := &starlingxv1.SystemList{}
+	err := c.List(context.TODO(), systems, client.InNamespace(instance.GetNamespace()))
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list systems in namespace %s", instance.GetNamespace())
+		return false, err
+	}
+
+	for i := range systems.Items {
+		if isDryRunAnnotated(&systems.Items[i]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isDryRunAnnotated determines whether the dry-run annotation is set on an
+// object.
+func isDryRunAnnotated(object client.Object) bool {
+	enabled, err := strconv.ParseBool(object.GetAnnotations()[manager.DryRunKey])
+	return err == nil && enabled
+}
+
+// DryRun runs a reconciler against a platform client which records the
+// requests which would modify the system instead of sending them and then
+// publishes the resulting plan in the status of the resource.
+type DryRun struct {
+	client   client.Client
+	manager  manager.CloudManager
+	events   ReconcilerEventLogger
+	instance DryRunInstance
+}
+
+// NewDryRun returns a DryRun for the resource if dry-run mode is enabled;
+// otherwise it returns nil after clearing any plan left over from a previous
+// dry-run.  The state of the instance is captured so that it can be restored
+// once the reconciler has run.
+func NewDryRun(c client.Client, mgr manager.CloudManager, events ReconcilerEventLogger, instance DryRunInstance) (*DryRun, error) {
+	enabled, err := IsDryRunEnabled(c, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := instance.(*starlingxv1.System); ok && enabled != (instance.GetStatusPlan() != nil) {
+		// The annotation of the system applies to the whole namespace so the
+		// other resources must be reconciled again to pick up the change.
+		err = mgr.NotifySystemDependencies(instance.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !enabled {
+		if instance.GetStatusPlan() != nil {
+			original := instance.DeepCopyObject().(DryRunInstance)
+			instance.SetStatusPlan(nil)
+			err = c.Status().Patch(context.TODO(), instance, client.MergeFrom(original))
+			if err != nil {
+				err = perrors.Wrapf(err, "failed to clear plan of %s", instance.GetName())
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	return &DryRun{
+		client:   c,
+		manager:  mgr,
+		events:   events,
+		instance: instance.DeepCopyObject().(DryRunInstance),
+	}, nil
+}
+
+// Run invokes the reconcile function with a platform client which records
+// the requests that would modify the system and publishes the resulting plan.
+// Any error returned by the reconcile function is reported in the plan rather
+// than returned since the resource cannot progress until dry-run mode is
+// disabled.
+func (d *DryRun) Run(c *gophercloud.ServiceClient, fn func(c *gophercloud.ServiceClient) error) (reconcile.Result, error) {
+	plan := &starlingxv1.PlanStatus{ObservedGeneration: d.instance.GetGeneration()}
+
+	if !d.instance.GetDeletionTimestamp().IsZero() {
+		// Deleting the resource would release its finalizer and therefore
+		// lose track of the system resources that it refers to.
+		plan.Error = dryRunDeferredDeletion
+	} else {
+		recorder := NewPlanRecorder(c)
+
+		d.manager.SetDryRun(d.instance, true)
+		err := fn(recorder.Client())
+		d.manager.SetDryRun(d.instance, false)
+
+		plan.Requests, plan.Truncated = recorder.Requests()
+		if err != nil {
+			plan.Error = errorMessage(err)
+		}
+	}
+
+	return reconcile.Result{}, d.publish(plan)
+}
+
+// publish restores the status of the resource to the state that it was in
+// before the reconciler ran, so that the resource is not reported as having
+// been reconciled, and stores the plan.
+func (d *DryRun) publish(plan *starlingxv1.PlanStatus) error {
+	latest := d.instance.DeepCopyObject().(DryRunInstance)
+	err := d.client.Get(context.TODO(), client.ObjectKeyFromObject(d.instance), latest)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to get %s", d.instance.GetName())
+		return err
+	}
+
+	result, err := restoreStatus(latest, d.instance)
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to restore status of %s", d.instance.GetName())
+		return err
+	}
+
+	changed := !samePlan(d.instance.GetStatusPlan(), plan)
+	if changed {
+		now := metav1.Now()
+		plan.GeneratedAt = &now
+	} else {
+		plan.GeneratedAt = d.instance.GetStatusPlan().GeneratedAt
+	}
+	result.SetStatusPlan(plan)
+
+	if !equality.Semantic.DeepEqual(latest, result) {
+		err = d.client.Status().Patch(context.TODO(), result, client.MergeFrom(latest))
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to publish plan of %s", d.instance.GetName())
+			return err
+		}
+	}
+
+	if changed {
+		d.events.NormalEvent(d.instance, ResourcePlanned,
+			"dry-run plan updated with %d request(s)", len(plan.Requests))
+	}
+
+	return nil
+}
+
+// samePlan determines whether two plans contain the same requests for the
+// same generation regardless of when they were generated.
+func samePlan(a, b *starlingxv1.PlanStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	x, y := a.DeepCopy(), b.DeepCopy()
+	x.GeneratedAt, y.GeneratedAt = nil, nil
+
+	return x.DeepEqual(y)
+}
+
+// restoreStatus returns a copy of the latest version of an object with its
+// status replaced by the status of a previous version.
+func restoreStatus(latest, previous client.Object) (DryRunInstance, error) {
+	latestData, err := toGeneric(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	previousData, err := toGeneric(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	latestData["status"] = previousData["status"]
+
+	data, err := json.Marshal(latestData)
+	if err != nil {
+		return nil, err
+	}
+
+	result := reflect.New(reflect.TypeOf(latest).Elem()).Interface().(DryRunInstance)
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PlanRecorder is an HTTP transport which records the requests which would
+// modify the system and answers them with a synthetic response.  Requests
+// which only read from the system are forwarded unchanged.
+type PlanRecorder struct {
+	client    *gophercloud.ServiceClient
+	next      http.RoundTripper
+	lock      sync.Mutex
+	requests  []starlingxv1.PlannedRequest
+	truncated bool
+}
+
+// NewPlanRecorder returns a PlanRecorder which wraps the transport of a
+// platform client.  The platform client itself is left unchanged.
+func NewPlanRecorder(c *gophercloud.ServiceClient) *PlanRecorder {
+	r := &PlanRecorder{next: c.HTTPClient.Transport}
+	if r.next == nil {
+		r.next = http.DefaultTransport
+	}
+
+	provider := *c.ProviderClient
+	provider.HTTPClient.Transport = r
+
+	result := *c
+	result.ProviderClient = &provider
+	r.client = &result
+
+	return r
+}
+
+// Client returns the platform client which records its requests.
+func (r *PlanRecorder) Client() *gophercloud.ServiceClient {
+	return r.client
+}
+
+// Requests returns the list of recorded requests and whether some requests
+// were omitted because of the size limit.
+func (r *PlanRecorder) Requests() ([]starlingxv1.PlannedRequest, bool) {
+	r.lock.Lock()
+	defer func() { r.lock.Unlock() }()
+
+	result := make([]starlingxv1.PlannedRequest, len(r.requests))
+	copy(result, r.requests)
+
+	return result, r.truncated
+}
+
+// redactBody returns a copy of a decoded request body with the sensitive
+// values replaced.  The system API updates attributes with JSON patch
+// operations therefore the value of an operation is redacted if its path
+// refers to a sensitive attribute.
+func redactBody(value interface{}) interface{} {
+	result := redact(value)
+
+	if list, ok := result.([]interface{}); ok {
+		for _, e := range list {
+			if op, ok := e.(map[string]interface{}); ok {
+				if path, ok := op["path"].(string); ok && isSensitive(path) {
+					if _, ok := op["value"]; ok {
+						op["value"] = redactedText
+					}
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// encodeBody returns the redacted JSON encoded representation of a request
+// body bounded to MaxPlannedBodyLength.
+func encodeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		// Never record a body which cannot be inspected for sensitive values.
+		return redactedText
+	}
+
+	data, err := json.Marshal(redactBody(value))
+	if err != nil {
+		return redactedText
+	}
+
+	result := string(data)
+	if len(result) > MaxPlannedBodyLength {
+		result = result[:MaxPlannedBodyLength-3] + "..."
+	}
+
+	return result
+}
+
+// add appends a request to the plan and returns its index.
+func (r *PlanRecorder) add(request starlingxv1.PlannedRequest) int {
+	r.lock.Lock()
+	defer func() { r.lock.Unlock() }()
+
+	index := len(r.requests)
+	if index >= MaxPlannedRequests {
+		r.truncated = true
+		return index
+	}
+
+	r.requests = append(r.requests, request)
+
+	return index
+}
+
+// record appends a request to the plan and returns its index.
+func (r *PlanRecorder) record(req *http.Request, body []byte) int {
+	path := req.URL.Path
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	return r.add(starlingxv1.PlannedRequest{
+		Method: req.Method,
+		Path:   path,
+		Body:   encodeBody(body),
+	})
+}
+
+// RecordPlannedAction records an action which is not performed through the
+// platform client, such as the notification of another resource, in the plan
+// of a dry-run.  It returns false if the platform client is not recording a
+// plan, in which case the action must be performed.
+func RecordPlannedAction(c *gophercloud.ServiceClient, action starlingxv1.PlannedRequest) bool {
+	if c == nil || c.ProviderClient == nil {
+		return false
+	}
+
+	r, ok := c.HTTPClient.Transport.(*PlanRecorder)
+	if !ok {
+		return false
+	}
+
+	r.add(action)
+
+	return true
+}
+
+// PlannedNotification returns the planned action which describes the
+// notification of a resource, expressed as the Kubernetes API request which
+// updates its notification annotation.
+func PlannedNotification(object client.Object, resource string) starlingxv1.PlannedRequest {
+	return starlingxv1.PlannedRequest{
+		Method: http.MethodPatch,
+		Path: fmt.Sprintf("/apis/%s/namespaces/%s/%s/%s",
+			starlingxv1.GroupVersion.String(), object.GetNamespace(), resource, object.GetName()),
+		Body: fmt.Sprintf(`{"metadata":{"annotations":{%q:"<incremented>"}}}`, manager.NotificationCountKey),
+	}
+}
+
+// respond builds a synthetic response to a request.
+func respond(req *http.Request, code int, body []byte) *http.Response {
+	header := make(http.Header)
+	if body != nil {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// created returns the synthetic content of a created resource.  The request
+// body is echoed back with a placeholder identifier so that the requests
+// which refer to the new resource can be planned.
+func created(body []byte, index int) []byte {
+	var value map[string]interface{}
+	if err := json.Unmarshal(body, &value); err != nil || value == nil {
+		value = make(map[string]interface{})
+	}
+
+	if _, ok := value["uuid"]; !ok {
+		value["uuid"] = fmt.Sprintf("dry-run-%d", index)
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return []byte("{}")
+	}
+
+	return result
+}
+
+// current returns the current content of the resource targeted by an update
+// request, if it can be read, so that the reconciler sees the resource
+// unchanged.
+func (r *PlanRecorder) current(req *http.Request) ([]byte, bool) {
+	get := req.Clone(req.Context())
+	get.Method = http.MethodGet
+	get.Body = nil
+	get.GetBody = nil
+	get.ContentLength = 0
+	get.Header.Del("Content-Type")
+
+	resp, err := r.next.RoundTrip(get)
+	if err != nil {
+		return nil, false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false
+	}
+
+	return body, true
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *PlanRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return r.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	index := r.record(req, body)
+
+	switch req.Method {
+	case http.MethodPost:
+		for _, suffix := range dryRunNoContentSuffixes {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return respond(req, http.StatusNoContent, nil), nil
+			}
+		}
+		return respond(req, http.StatusCreated, created(body, index)), nil
+
+	case http.MethodDelete:
+		return respond(req, http.StatusNoContent, nil), nil
+
+	default:
+		if content, ok := r.current(req); ok {
+			return respond(req, http.StatusOK, content), nil
+		} else if req.Method == http.MethodPut && len(body) > 0 {
+			return respond(req, http.StatusOK, body), nil
+		}
+		return respond(req, http.StatusOK, []byte("{}")), nil
+	}
+}
@@ -0,0 +1,236 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Dry-run utils", func() {
+	var server *httptest.Server
+	var received []string
+	var platformClient *gophercloud.ServiceClient
+
+	BeforeEach(func() {
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = append(received, r.Method+" "+r.URL.Path)
+			if r.Method != http.MethodGet || r.URL.Path != "/v1/ihosts/host-1" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"uuid":"host-1","administrative":"unlocked"}`)
+		}))
+		platformClient = &gophercloud.ServiceClient{
+			ProviderClient: &gophercloud.ProviderClient{},
+			Endpoint:       server.URL + "/v1/",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Test PlanRecorder", func() {
+		It("should record the requests which modify the system", func() {
+			recorder := NewPlanRecorder(platformClient)
+			c := recorder.Client()
+			Expect(c.HTTPClient.Transport).ToNot(Equal(platformClient.HTTPClient.Transport))
+
+			var host map[string]interface{}
+			_, err := c.Get(c.ServiceURL("ihosts", "host-1"), &host, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var created map[string]interface{}
+			_, err = c.Post(c.ServiceURL("iinterfaces"), map[string]interface{}{"ifname": "data0"}, &created, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(Equal(map[string]interface{}{"ifname": "data0", "uuid": "dry-run-0"}))
+
+			var updated map[string]interface{}
+			ops := []map[string]interface{}{
+				{"op": "replace", "path": "/action", "value": "lock"},
+				{"op": "replace", "path": "/bm_password", "value": "s3cret"},
+			}
+			_, err = c.Patch(c.ServiceURL("ihosts", "host-1"), ops, &updated, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated["administrative"]).To(Equal("unlocked"))
+
+			_, err = c.Post(c.ServiceURL("ptp_instances", "apply"), map[string]interface{}{}, nil, &gophercloud.RequestOpts{
+				OkCodes: []int{204},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = c.Delete(c.ServiceURL("iinterfaces", "mgmt0"), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(received).To(Equal([]string{"GET /v1/ihosts/host-1", "GET /v1/ihosts/host-1"}))
+
+			requests, truncated := recorder.Requests()
+			Expect(truncated).To(BeFalse())
+			Expect(requests).To(HaveLen(4))
+			Expect(requests[0]).To(Equal(starlingxv1.PlannedRequest{
+				Method: http.MethodPost, Path: "/v1/iinterfaces", Body: `{"ifname":"data0"}`}))
+			Expect(requests[1].Method).To(Equal(http.MethodPatch))
+			Expect(requests[1].Body).To(ContainSubstring("lock"))
+			Expect(requests[1].Body).ToNot(ContainSubstring("s3cret"))
+			Expect(requests[2].Path).To(Equal("/v1/ptp_instances/apply"))
+			Expect(requests[3]).To(Equal(starlingxv1.PlannedRequest{Method: http.MethodDelete, Path: "/v1/iinterfaces/mgmt0"}))
+		})
+		It("should bound the number of requests", func() {
+			recorder := NewPlanRecorder(platformClient)
+			c := recorder.Client()
+			for i := 0; i < MaxPlannedRequests+5; i++ {
+				_, err := c.Delete(c.ServiceURL("iinterfaces", fmt.Sprintf("if%d", i)), nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			requests, truncated := recorder.Requests()
+			Expect(requests).To(HaveLen(MaxPlannedRequests))
+			Expect(truncated).To(BeTrue())
+		})
+		It("should record planned actions only while recording a plan", func() {
+			host := &starlingxv1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "default"}}
+			action := PlannedNotification(host, "hosts")
+			Expect(action.Path).To(Equal("/apis/starlingx.windriver.com/v1/namespaces/default/hosts/controller-0"))
+			Expect(action.Body).To(ContainSubstring(manager.NotificationCountKey))
+
+			Expect(RecordPlannedAction(platformClient, action)).To(BeFalse())
+			Expect(RecordPlannedAction(nil, action)).To(BeFalse())
+
+			recorder := NewPlanRecorder(platformClient)
+			Expect(RecordPlannedAction(recorder.Client(), action)).To(BeTrue())
+
+			requests, _ := recorder.Requests()
+			Expect(requests).To(Equal([]starlingxv1.PlannedRequest{action}))
+			Expect(received).To(BeEmpty())
+		})
+	})
+
+	Describe("Test DryRun", func() {
+		var scheme *runtime.Scheme
+		var instance *starlingxv1.PtpInstance
+		var events *record.FakeRecorder
+		var logger *EventLogger
+
+		BeforeEach(func() {
+			scheme = runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			instance = &starlingxv1.PtpInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ptp1",
+					Namespace:   "default",
+					Generation:  2,
+					Annotations: map[string]string{manager.DryRunKey: "true"},
+				},
+			}
+
+			events = record.NewFakeRecorder(10)
+			logger = &EventLogger{EventRecorder: events, Logger: logr.Discard()}
+		})
+
+		It("should honour the annotation on the system", func() {
+			instance.Annotations = nil
+			system := &starlingxv1.System{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "system",
+					Namespace:   "default",
+					Annotations: map[string]string{manager.DryRunKey: "True"},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(system).Build()
+
+			enabled, err := IsDryRunEnabled(c, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+
+			system.Annotations[manager.DryRunKey] = "false"
+			Expect(c.Update(context.TODO(), system)).To(Succeed())
+			enabled, err = IsDryRunEnabled(c, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("should publish the plan and restore the status", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+			mgr := &manager.Dummymanager{Client: c}
+
+			dryRun, err := NewDryRun(c, mgr, logger, instance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dryRun).ToNot(BeNil())
+
+			result, err := dryRun.Run(platformClient, func(pc *gophercloud.ServiceClient) error {
+				_, err := pc.Post(pc.ServiceURL("ptp_instances"), map[string]interface{}{"name": "ptp1"}, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				instance.Status.Reconciled = true
+				Expect(c.Status().Update(context.TODO(), instance)).To(Succeed())
+
+				return NewValidationError("waiting for the interface")
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(received).To(BeEmpty())
+
+			latest := &starlingxv1.PtpInstance{}
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(instance), latest)).To(Succeed())
+			Expect(latest.Status.Reconciled).To(BeFalse())
+			Expect(latest.Status.Plan).ToNot(BeNil())
+			Expect(latest.Status.Plan.ObservedGeneration).To(Equal(int64(2)))
+			Expect(latest.Status.Plan.GeneratedAt).ToNot(BeNil())
+			Expect(latest.Status.Plan.Requests).To(Equal([]starlingxv1.PlannedRequest{
+				{Method: http.MethodPost, Path: "/v1/ptp_instances", Body: `{"name":"ptp1"}`}}))
+			Expect(latest.Status.Plan.Error).To(Equal("waiting for the interface"))
+			Expect(events.Events).To(Receive(ContainSubstring("dry-run plan updated with 1 request(s)")))
+
+			latest.Annotations = nil
+			Expect(c.Update(context.TODO(), latest)).To(Succeed())
+			dryRun, err = NewDryRun(c, mgr, logger, latest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dryRun).To(BeNil())
+
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(instance), latest)).To(Succeed())
+			Expect(latest.Status.Plan).To(BeNil())
+		})
+
+		It("should defer the deletion of the resource", func() {
+			now := metav1.Now()
+			instance.DeletionTimestamp = &now
+			instance.Finalizers = []string{"ptpinstance.finalizers.windriver.com"}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+
+			dryRun, err := NewDryRun(c, &manager.Dummymanager{Client: c}, logger, instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			called := false
+			_, err = dryRun.Run(platformClient, func(pc *gophercloud.ServiceClient) error {
+				called = true
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeFalse())
+
+			latest := &starlingxv1.PtpInstance{}
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(instance), latest)).To(Succeed())
+			Expect(latest.Status.Plan.Error).To(Equal(dryRunDeferredDeletion))
+		})
+	})
+})
@@ -5,10 +5,8 @@ package common
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"strings"
 
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,67 +21,16 @@ type StarlingxInstance interface {
 	SetDeploymentScope(scope string)
 }
 
-func extractScope(config string) (string, error) {
-	var data map[string]interface{}
-	err := json.Unmarshal([]byte(config), &data)
-	if err != nil {
-		return "", err
-	}
-
-	status, ok := data["status"].(map[string]interface{})
-	if !ok {
-		return "", nil
-	}
-
-	scope, ok := status["deploymentScope"].(string)
-	if !ok {
-		return "", nil
-	}
-
-	return scope, nil
-}
-
-// GetDeploymentScope is to get the deploymentScope from the last applied configuration
-// and set expected value based on the data.
+// GetDeploymentScope returns the deployment scope declared for the resource
+// either through the DeploymentScopeKey annotation or, for configurations
+// applied before the annotation was introduced, through the deploymentScope
+// status attribute of the last applied configuration.  The bootstrap scope
+// is returned by default.
 func GetDeploymentScope(instance StarlingxInstance) (string, error) {
-	// Set default value for deployment scope
-	scope := manager.ScopeBootstrap
-	// Set DeploymentScope from configuration
-	annotation := instance.GetObjectMeta().GetAnnotations()
-	if annotation == nil {
-		return scope, nil
-	}
-
-	config, ok := annotation["kubectl.kubernetes.io/last-applied-configuration"]
-	if !ok {
-		return scope, nil
-	}
-
-	scope2, err := extractScope(config)
-	if err != nil {
-		return scope, err
-	}
-
-	if scope2 != "" {
-		scope = scope2
-	}
-
-	scope, err = validateDeploymentScope(scope)
+	scope, _, err := starlingxv1.GetDeclaredDeploymentScope(instance.GetObjectMeta())
 	return scope, err
 }
 
-func validateDeploymentScope(scope string) (string, error) {
-	lowerCaseScope := strings.ToLower(scope)
-	switch lowerCaseScope {
-	case manager.ScopeBootstrap:
-		return manager.ScopeBootstrap, nil
-	case manager.ScopePrincipal:
-		return manager.ScopePrincipal, nil
-	default:
-		return manager.ScopeBootstrap, fmt.Errorf("unsupported DeploymentScope: %s", scope)
-	}
-}
-
 func UpdateDeploymentScope(client client.Client, instance StarlingxInstance) (bool, error) {
 	scope, err := GetDeploymentScope(instance)
 	if err != nil {
@@ -52,53 +52,6 @@ func (m *MockStarlingxInstance) DeepCopyObject() runtime.Object {
 	return &copied
 }
 
-func TestExtractScope(t *testing.T) {
-	tests := []struct {
-		name    string
-		config  string
-		want    string
-		wantErr bool
-	}{
-		{
-			name:    "Valid JSON with deployment scope",
-			config:  `{"status":{"deploymentScope":"principal"}}`,
-			want:    "principal",
-			wantErr: false,
-		},
-		{
-			name:    "Invalid JSON format",
-			config:  `{"status":{"deploymentScope":"principal"`,
-			want:    "",
-			wantErr: true,
-		},
-		{
-			name:    "Missing deployment scope field",
-			config:  `{"status":{}}`,
-			want:    "",
-			wantErr: false,
-		},
-		{
-			name:    "Missing Status",
-			config:  `{}`,
-			want:    "",
-			wantErr: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := extractScope(tt.config)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("extractScope() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("extractScope() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
 func TestGetDeploymentScope(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -128,6 +81,23 @@ func TestGetDeploymentScope(t *testing.T) {
 			expectedScope: "bootstrap",
 			expectError:   true,
 		},
+		{
+			name: "Scope annotation takes precedence",
+			annotations: map[string]string{
+				manager.DeploymentScopeKey:                         "principal",
+				"kubectl.kubernetes.io/last-applied-configuration": `{"status":{"deploymentScope":"bootstrap"}}`,
+			},
+			expectedScope: "principal",
+			expectError:   false,
+		},
+		{
+			name: "Invalid scope annotation",
+			annotations: map[string]string{
+				manager.DeploymentScopeKey: "Principal",
+			},
+			expectedScope: "bootstrap",
+			expectError:   true,
+		},
 		{
 			name: "Missing deploymentScope field",
 			annotations: map[string]string{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -16,6 +17,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -128,10 +130,9 @@ func (r *DataNetworkReconciler) ReconcileNew(client *gophercloud.ServiceClient,
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
-		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+		if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 			msg := common.NoProvisioningAfterReconciled
-			r.NormalEvent(instance, common.ResourceUpdated, msg)
-			return nil, common.NewChangeAfterInSync(msg)
+			return nil, common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 		} else {
 			logDataNetwork.Info(common.ProvisioningAllowedAfterReconciled)
 		}
@@ -176,10 +177,9 @@ func (r *DataNetworkReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
-			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 				msg := common.NoChangesAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			} else {
 				logDataNetwork.Info(common.ChangedAllowedAfterReconciled)
 			}
@@ -266,7 +266,7 @@ func (r *DataNetworkReconciler) statusUpdateRequired(instance *starlingxv1.DataN
 		status.ConfigurationUpdated = false
 		status.StrategyRequired = cloudManager.StrategyNotRequired
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
-			r.SetResourceInfo(cloudManager.ResourceDatanetwork, "", instance.Name, status.Reconciled, status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceDatanetwork, "", instance.Name, status.Reconciled, status.StrategyRequired)
 		}
 		result = true
 	}
@@ -364,10 +364,21 @@ func (r *DataNetworkReconciler) ReconcileResource(client *gophercloud.ServiceCli
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *DataNetworkReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.DataNetwork, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *DataNetworkReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.DataNetwork)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *DataNetworkReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.DataNetwork, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // Update ReconcileAfterInSync in instance
@@ -433,8 +444,8 @@ func (r *DataNetworkReconciler) UpdateConfigStatus(instance *starlingxv1.DataNet
 				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 					instance.Status.Reconciled = false
 					// Update strategy required status for strategy monitor
-					r.UpdateConfigVersion()
-					r.SetResourceInfo(cloudManager.ResourceDatanetwork, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+					r.UpdateConfigVersion(instance.Namespace)
+					r.SetResourceInfo(instance.Namespace, cloudManager.ResourceDatanetwork, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
 				}
 			}
 			instance.Status.ObservedGeneration = instance.Generation
@@ -597,13 +608,16 @@ func (r *DataNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logDataNetwork}
+		Logger:       logDataNetwork,
+		Kind:         "DataNetwork"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(DataNetworkControllerName),
 		Logger:        logDataNetwork}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.DataNetwork{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("DataNetwork",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -21,6 +22,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -669,8 +671,12 @@ func (r *HostReconciler) ReconcileInitialState(client *gophercloud.ServiceClient
 
 	if desiredState != nil && *desiredState != host.AdministrativeState &&
 		instance.Status.DeploymentScope == cloudManager.ScopeBootstrap &&
-		!r.GetStrategySent() {
+		!r.GetStrategySent(instance.Namespace) {
 		if *desiredState == hosts.AdminLocked {
+			if err := r.ReconcileMaintenanceWindow(instance, "host lock"); err != nil {
+				return err
+			}
+
 			action := hosts.ActionLock
 			opts := hosts.HostOpts{
 				Action: &action,
@@ -699,6 +705,52 @@ func (r *HostReconciler) ReconcileInitialState(client *gophercloud.ServiceClient
 	return nil
 }
 
+// ReconcileMaintenanceWindow determines whether a disruptive action may be
+// performed on the host.  The maintenance windows of the host are used if
+// set; otherwise those of the System apply.  Outside of a window the host
+// status reflects the pending action and a monitor is started to kick the
+// reconciler once the next window opens.
+func (r *HostReconciler) ReconcileMaintenanceWindow(instance *starlingxv1.Host, pending string) error {
+	maintenance := instance.Spec.Maintenance
+	if maintenance == nil {
+		maintenance = r.GetMaintenanceWindows(instance.Namespace)
+	}
+
+	var status *starlingxv1.MaintenanceWindowStatus
+	open, next := cloudManager.MaintenanceWindowOpen(maintenance, time.Now())
+	if !open {
+		status = &starlingxv1.MaintenanceWindowStatus{Pending: pending, NextWindow: next}
+	}
+
+	previous := instance.Status.Maintenance
+	if (previous != nil || status != nil) &&
+		(previous == nil || status == nil || !previous.DeepEqual(status)) {
+		instance.Status.Maintenance = status
+		err := r.Status().Update(context.TODO(), instance)
+		if err != nil {
+			err = perrors.Wrapf(err, "failed to update status: %s",
+				common.FormatStruct(instance.Status))
+			return err
+		}
+	}
+
+	if open {
+		return nil
+	}
+
+	msg := fmt.Sprintf("%s is waiting for the next maintenance window", pending)
+	if next != nil {
+		msg = fmt.Sprintf("%s at %s", msg, next.UTC().Format(time.RFC3339))
+	}
+
+	if previous == nil || previous.Pending != pending {
+		r.NormalEvent(instance, cloudManager.MaintenanceWindowClosedReason, msg)
+	}
+
+	m := NewMaintenanceWindowMonitor(instance, maintenance)
+	return r.StartMonitor(m, msg)
+}
+
 // MinimumEnabledControllerNodesForNonController defines the minimum acceptable
 // number of controller nodes that must be enable prior to unlocking a non-
 // controller node.
@@ -1181,7 +1233,7 @@ func (r *HostReconciler) ReconcileHostByState(
 					instance.Status.StrategyRequired = cloudManager.StrategyLockRequired
 					logHost.V(2).Info("set lock required")
 				}
-				r.SetResourceInfo(cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
+				r.SetResourceInfo(instance.Namespace, cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
 				err := r.Status().Update(context.TODO(), instance)
 				if err != nil {
 					err = perrors.Wrapf(err, "failed to update status: %s",
@@ -1198,10 +1250,10 @@ func (r *HostReconciler) ReconcileHostByState(
 
 		// Clean up strategy required after Disabled and enabled attributes are all in-sync
 		if strategy_required &&
-			!r.GetStrategyExpectedByOtherReconcilers() && !r.GetStrategySent() {
+			!r.GetStrategyExpectedByOtherReconcilers(instance.Namespace) && !r.GetStrategySent(instance.Namespace) {
 			logHost.V(2).Info("set strategy not required as attributes are all configured")
 			instance.Status.StrategyRequired = cloudManager.StrategyNotRequired
-			r.SetResourceInfo(cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
 			err := r.Status().Update(context.TODO(), instance)
 			if err != nil {
 				err = perrors.Wrapf(err, "failed to update status: %s",
@@ -1224,7 +1276,7 @@ func (r *HostReconciler) ReconcileHostByState(
 		if strategy_required {
 			instance.Status.StrategyRequired = cloudManager.StrategyUnlockRequired
 			logHost.V(2).Info("set unlock required. Lock required attributes are configured")
-			r.SetResourceInfo(cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceHost, host.Personality, instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
 			err := r.Status().Update(context.TODO(), instance)
 			if err != nil {
 				err = perrors.Wrapf(err, "failed to update status: %s",
@@ -1415,7 +1467,7 @@ func (r *HostReconciler) statusUpdateRequired(instance *starlingxv1.Host, host *
 			status.StrategyRequired = cloudManager.StrategyUnlockRequired
 			strategyUpdated = true
 		} else if status.StrategyRequired != cloudManager.StrategyNotRequired &&
-			!r.GetStrategyExpectedByOtherReconcilers() && !r.GetStrategySent() {
+			!r.GetStrategyExpectedByOtherReconcilers(instance.Namespace) && !r.GetStrategySent(instance.Namespace) {
 			logHost.V(2).Info("set not required: reconcile finished")
 			status.StrategyRequired = cloudManager.StrategyNotRequired
 			strategyUpdated = true
@@ -1426,7 +1478,7 @@ func (r *HostReconciler) statusUpdateRequired(instance *starlingxv1.Host, host *
 		logHost.V(2).Info("set profile config updated false: reconcile finished")
 		// Update resource info for Day-2 operation
 		if strategyUpdated {
-			r.SetResourceInfo(cloudManager.ResourceHost, host.Personality, instance.Name, status.Reconciled, status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceHost, host.Personality, instance.Name, status.Reconciled, status.StrategyRequired)
 		}
 		result = true
 	}
@@ -1504,6 +1556,10 @@ func (r *HostReconciler) reinstallAllowed(
 func (r *HostReconciler) hostReinstall(
 	host *hosts.Host, client *gophercloud.ServiceClient, instance *starlingxv1.Host,
 ) (*hosts.Host, error) {
+	if err := r.ReconcileMaintenanceWindow(instance, "host reinstall"); err != nil {
+		return nil, err
+	}
+
 	action := hosts.ActionReinstall
 	opts := hosts.HostOpts{Action: &action}
 	host, err := hosts.Update(client, host.ID, opts).Extract()
@@ -1542,10 +1598,9 @@ func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, ins
 			if instance.Status.Reconciled && r.StopAfterInSync() {
 				// Do not process any further changes once we have reached a
 				// synchronized state unless there is an annotation on the host.
-				if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+				if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 					msg := common.NoProvisioningAfterReconciled
-					r.NormalEvent(instance, common.ResourceUpdated, msg)
-					return nil, common.NewChangeAfterInSync(msg)
+					return nil, common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 				} else {
 					logHost.Info(common.ProvisioningAllowedAfterReconciled)
 				}
@@ -1608,10 +1663,21 @@ func (r *HostReconciler) ReconcileNewHost(client *gophercloud.ServiceClient, ins
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *HostReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.Host, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *HostReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.Host)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *HostReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.Host, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // ReconcileExistingHost is responsible for dealing with the provisioning of an
@@ -1850,10 +1916,17 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	logHost.Info("final profile is:", "values", profile)
 	logHost.Info("current config is:", "values", current)
 
+	if instance.Status.Reconciled && r.SyncMode() == utils.SyncModeDetect {
+		// Drift is only reported in detection mode so never apply changes,
+		// not even to complete a pending strategy.
+		msg := common.NoChangesAfterReconciled
+		return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
+	}
+
 	if instance.Status.Reconciled &&
 		r.StopAfterInSync() &&
 		instance.Status.StrategyRequired != cloudManager.StrategyLockRequired {
-		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+		if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 			if !host.IsUnlockedAvailable() {
 				msg := "waiting for the host reach available state: unlocked/enabled/evailable"
 				r.NormalEvent(instance, common.ResourceDependency, msg)
@@ -1863,8 +1936,7 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 				// Do not process any further changes once we have reached a
 				// synchronized state unless there is an annotation on the host.
 				msg := common.NoChangesAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			}
 		} else {
 			logHost.Info(common.ChangedAllowedAfterReconciled)
@@ -1882,6 +1954,23 @@ func (r *HostReconciler) ReconcileExistingHost(client *gophercloud.ServiceClient
 	return nil
 }
 
+// desiredStateReached determines whether a reconciled host has reached its
+// desired state so that it does not need to be compared against the system
+// again.  That is never the case in drift detection mode since the host must
+// be read back from the system to detect any drift.
+func desiredStateReached(instance *starlingxv1.Host, mode utils.SyncMode, updateRequired bool) bool {
+	if mode == utils.SyncModeDetect {
+		return false
+	}
+
+	return instance.Status.ObservedGeneration == instance.Generation &&
+		instance.Status.Reconciled &&
+		instance.Status.DeploymentScope == "bootstrap" &&
+		instance.Status.AvailabilityStatus != nil && *instance.Status.AvailabilityStatus == "available" &&
+		instance.Status.StrategyRequired == cloudManager.StrategyNotRequired &&
+		!updateRequired
+}
+
 // ReconcileDeletedHost is responsible for dealing with the provisioning of an
 // existing host.
 func (r *HostReconciler) ReconcileDeletedHost(client *gophercloud.ServiceClient, instance *starlingxv1.Host, host *hosts.Host) (err error) {
@@ -2303,12 +2392,12 @@ func (r *HostReconciler) UpdateConfigStatus(
 				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 					instance.Status.Reconciled = false
 					// Update strategy required status for strategy monitor
-					r.UpdateConfigVersion()
+					r.UpdateConfigVersion(instance.Namespace)
 					if hostProfile.Spec.Personality == nil &&
 						profile.Personality != nil {
 						hostProfile.Spec.Personality = profile.Personality
 					}
-					r.SetResourceInfo(cloudManager.ResourceHost, *hostProfile.Spec.Personality, instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+					r.SetResourceInfo(instance.Namespace, cloudManager.ResourceHost, *hostProfile.Spec.Personality, instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
 				}
 			}
 			instance.Status.ObservedGeneration = instance.Generation
@@ -2426,13 +2515,7 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 
 	// TODO(wasnio): remove this once migration from helm chart to fluxcd is done
 	// The status reaches its desired status post reconciled
-	if instance.Status.ObservedGeneration == instance.Generation &&
-		instance.Status.Reconciled &&
-		instance.Status.DeploymentScope == "bootstrap" &&
-		instance.Status.AvailabilityStatus != nil && *instance.Status.AvailabilityStatus == "available" &&
-		instance.Status.StrategyRequired == cloudManager.StrategyNotRequired &&
-		!updateRequired {
-
+	if desiredStateReached(instance, r.SyncMode(), updateRequired) {
 		if !scope_updated {
 			logHost.V(2).Info("reconcile finished, desired state reached after reconciled.")
 			return reconcile.Result{}, nil
@@ -2514,6 +2597,15 @@ func (r *HostReconciler) Reconcile(ctx context.Context, request ctrl.Request) (r
 		logHost.V(2).Info("not storage node or in ceph primary group. continue")
 	}
 
+	dryRun, err := common.NewDryRun(r.Client, r.CloudManager, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if dryRun != nil {
+		return dryRun.Run(platformClient, func(c *gophercloud.ServiceClient) error {
+			return r.ReconcileResource(c, instance, profile, request.Namespace)
+		})
+	}
+
 	err = r.ReconcileResource(platformClient, instance, profile, request.Namespace)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
@@ -2610,15 +2702,15 @@ func (r *HostReconciler) GetAddressPoolsFromPlatformNetwork(associated_addrpools
 func (r *HostReconciler) LockHostRequestByOtherController(host_instance *starlingxv1.Host, host_id string, host_personality string, set_res_info bool) error {
 
 	if host_instance.Status.StrategyRequired != cloudManager.StrategyLockRequired {
-		if !r.GetStrategyExpectedByOtherReconcilers() {
-			r.SetStrategyExpectedByOtherReconcilers(true)
+		if !r.GetStrategyExpectedByOtherReconcilers(host_instance.Namespace) {
+			r.SetStrategyExpectedByOtherReconcilers(host_instance.Namespace, true)
 			logHost.Info("StrategyExpectedByOtherReoncilers has been set to true.")
 		}
 
 		logHost.Info(fmt.Sprintf("Updating strategyRequired to lock_required for %s.", host_instance.Name))
 		host_instance.Status.StrategyRequired = cloudManager.StrategyLockRequired
 		if set_res_info {
-			r.SetResourceInfo(cloudManager.ResourceHost,
+			r.SetResourceInfo(host_instance.Namespace, cloudManager.ResourceHost,
 				host_personality,
 				host_instance.Name,
 				host_instance.Status.Reconciled,
@@ -2656,13 +2748,16 @@ func (r *HostReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logHost}
+		Logger:       logHost,
+		Kind:         "Host"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(HostControllerName),
 		Logger:        logHost}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.Host{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("Host",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
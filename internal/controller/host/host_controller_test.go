@@ -425,6 +425,31 @@ var _ = Describe("Host controller", func() {
 		})
 	})
 
+	Context("when calling desiredStateReached", func() {
+		newReconciledHost := func() *starlingxv1.Host {
+			available := "available"
+			return &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: starlingxv1.HostStatus{
+					ObservedGeneration: 2,
+					Reconciled:         true,
+					DeploymentScope:    "bootstrap",
+					AvailabilityStatus: &available,
+					StrategyRequired:   cloudManager.StrategyNotRequired,
+				},
+			}
+		}
+		It("should return true for a reconciled and available bootstrap host", func() {
+			Expect(desiredStateReached(newReconciledHost(), comm.SyncModeStop, false)).To(BeTrue())
+		})
+		It("should return false when a platform network update is required", func() {
+			Expect(desiredStateReached(newReconciledHost(), comm.SyncModeStop, true)).To(BeFalse())
+		})
+		It("should return false in drift detection mode so that the host is read back", func() {
+			Expect(desiredStateReached(newReconciledHost(), comm.SyncModeDetect, false)).To(BeFalse())
+		})
+	})
+
 	Context("when calling MonitorsEnabled", func() {
 		It("should return true if function is monitor and the host is unlocked enabled", func() {
 			required := 1
@@ -776,3 +776,48 @@ func (m *stateChangeMonitor) Run(client *gophercloud.ServiceClient) (stop bool,
 		return true, nil
 	}
 }
+
+// DefaultMaintenanceWindowMonitorInterval represents the default interval
+// between checks of whether a maintenance window has opened.
+const DefaultMaintenanceWindowMonitorInterval = time.Minute
+
+// maintenanceWindowMonitor waits for the next maintenance window to open so
+// that a disruptive action can be performed on the host.  Once the window is
+// open a reconcilable event is generated to kick the reconciler.
+type maintenanceWindowMonitor struct {
+	manager.CommonMonitorBody
+	maintenance *starlingxv1.MaintenanceInfo
+}
+
+// NewMaintenanceWindowMonitor defines a convenience function to instantiate
+// a new maintenance window monitor with all required attributes.
+func NewMaintenanceWindowMonitor(instance *starlingxv1.Host, maintenance *starlingxv1.MaintenanceInfo) *manager.Monitor {
+	logger := logHost.WithName("maintenance-window-monitor")
+	return &manager.Monitor{
+		MonitorBody: &maintenanceWindowMonitor{
+			maintenance: maintenance.DeepCopy(),
+		},
+		Logger:   logger,
+		Object:   instance,
+		Interval: DefaultMaintenanceWindowMonitorInterval,
+	}
+}
+
+// Run implements the MonitorBody interface Run method which is responsible
+// for monitor one or more resources and returning true when all conditions
+// are satisfied.
+func (m *maintenanceWindowMonitor) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	open, next := manager.MaintenanceWindowOpen(m.maintenance, time.Now())
+	if open {
+		m.SetState("maintenance window is now open")
+		return true, nil
+	}
+
+	if next != nil {
+		m.SetState("waiting for maintenance window opening at %s", next.UTC().Format(time.RFC3339))
+	} else {
+		m.SetState("waiting for maintenance window")
+	}
+
+	return false, nil
+}
@@ -11,6 +11,7 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -158,15 +159,18 @@ func (r *HostProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	tMgr := cloudManager.GetInstance(mgr)
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
 		Logger:       logAddressPool,
+		Kind:         "HostProfile",
 	}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(HostProfileControllerName),
 		Logger:        logHostProfile}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.HostProfile{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("HostProfile",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
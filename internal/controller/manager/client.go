@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -19,6 +19,7 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	perrors "github.com/pkg/errors"
 	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -197,19 +198,27 @@ func parseURLs(authURL string) ([]string, error) {
 }
 
 func GetAuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
-	password := os.Getenv(PasswordKey)
-	username := os.Getenv(UsernameKey)
-	authURL := os.Getenv(AuthUrlKey)
-	userID := os.Getenv(UserIDKey)
-	tenantID := os.Getenv(TenantIDKey)
-	tenantName := os.Getenv(TenantNameKey)
-	domainID := os.Getenv(DomainIDKey)
-	domainName := os.Getenv(DomainNameKey)
-	applicationCredentialID := os.Getenv(ApplicationCredentialIDKey)
-	applicationCredentialName := os.Getenv(ApplicationCredentialNameKey)
-	applicationCredentialSecret := os.Getenv(ApplicationCredentialSecretKey)
-	projectID := os.Getenv(ProjectIDKey)
-	projectName := os.Getenv(ProjectNameKey)
+	return GetAuthOptionsFromLookup(os.Getenv)
+}
+
+// GetAuthOptionsFromLookup builds the authentication options from the standard
+// OpenStack environment variable names while delegating the retrieval of each
+// value to the supplied lookup function.  This allows credentials to be read
+// from sources other than the process environment (e.g., an openrc file).
+func GetAuthOptionsFromLookup(lookup func(string) string) (gophercloud.AuthOptions, error) {
+	password := lookup(PasswordKey)
+	username := lookup(UsernameKey)
+	authURL := lookup(AuthUrlKey)
+	userID := lookup(UserIDKey)
+	tenantID := lookup(TenantIDKey)
+	tenantName := lookup(TenantNameKey)
+	domainID := lookup(DomainIDKey)
+	domainName := lookup(DomainNameKey)
+	applicationCredentialID := lookup(ApplicationCredentialIDKey)
+	applicationCredentialName := lookup(ApplicationCredentialNameKey)
+	applicationCredentialSecret := lookup(ApplicationCredentialSecretKey)
+	projectID := lookup(ProjectIDKey)
+	projectName := lookup(ProjectNameKey)
 
 	if projectID != "" {
 		// If OS_PROJECT_ID is set, overwrite tenantID with the value.
@@ -353,6 +362,9 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 		c.HTTPClient.Transport = &clients.LogRoundTripper{Rt: t}
 	}
 
+	// Record the latency and errors of all API requests.
+	c.HTTPClient.Transport = metrics.NewAPIRoundTripper(endpointName, c.HTTPClient.Transport)
+
 	switch endpointName {
 	case SystemEndpointName:
 
@@ -369,7 +381,6 @@ func (m *PlatformManager) BuildPlatformClient(namespace string, endpointName str
 
 		if obj, ok := m.systems[namespace]; !ok {
 			m.systems[namespace] = &SystemNamespace{client: c}
-			m.strategyStatus.Namespace = namespace
 		} else {
 			obj.client = c
 		}
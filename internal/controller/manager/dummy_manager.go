@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2024 Wind River Systems, Inc. */
+/* Copyright(c) 2024-2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
@@ -16,6 +17,7 @@ import (
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -38,10 +40,24 @@ type Dummymanager struct {
 	defaultUpdated     bool          // Simulate default update status
 	DefaultUpdateError error         // Simulate error for default update method
 	Client             client.Client // Added client field for the Kubernetes client
+	Recorder           *record.FakeRecorder
 	ActiveHost         *starlingxv1.Host
 
 	MonitorStarted bool   // Track if StartMonitor was called
 	MonitorMessage string // Track the message passed to StartMonitor
+
+	NotifiedResources []string // Track the names of the notified resources
+
+	strategyProgress *starlingxv1.SystemStrategyStatus // Track the last strategy progress
+	strategyOptions  *starlingxv1.StrategyInfo         // Simulate the configured strategy options
+	strategyApproval StrategyApproval                  // Simulate the strategy approval annotations
+	strategyAction   string                            // Track the last strategy action sent
+
+	maintenance        *starlingxv1.MaintenanceInfo         // Simulate the System maintenance windows
+	maintenancePending *starlingxv1.MaintenanceWindowStatus // Track the last maintenance status
+
+	nextRetry       time.Time                          // Track the next strategy retry time
+	strategyHistory []starlingxv1.StrategyHistoryEntry // Track the recorded strategy history
 }
 
 func (m *Dummymanager) ResetPlatformClient(namespace string) error {
@@ -52,7 +68,7 @@ func (m *Dummymanager) GetPlatformClient(namespace string) *gophercloud.ServiceC
 	return c
 }
 func (m *Dummymanager) GetKubernetesClient() client.Client {
-	return nil
+	return m.Client
 }
 func (m *Dummymanager) BuildPlatformClient(namespace string, endpointName string, endpointType string) (*gophercloud.ServiceClient, error) {
 	c := &gophercloud.ServiceClient{}
@@ -62,6 +78,7 @@ func (m *Dummymanager) NotifySystemDependencies(namespace string) error {
 	return nil
 }
 func (m *Dummymanager) NotifyResource(object client.Object) error {
+	m.NotifiedResources = append(m.NotifiedResources, object.GetName())
 	return nil
 }
 func (m *Dummymanager) SetSystemReady(namespace string, value bool) {
@@ -85,46 +102,54 @@ func (m *Dummymanager) CancelMonitor(object client.Object) {
 	m.MonitorStarted = false
 	m.MonitorMessage = ""
 }
+func (m *Dummymanager) ListMonitors() []MonitorDescription {
+	return nil
+}
+func (m *Dummymanager) SetDryRun(object client.Object, enabled bool) {
+}
+func (m *Dummymanager) GetEventRecorderFor(name string) record.EventRecorder {
+	if m.Recorder != nil {
+		return m.Recorder
+	}
+	return &record.FakeRecorder{}
+}
 func (m *Dummymanager) GetActiveHost(namespace string, client *gophercloud.ServiceClient) (*starlingxv1.Host, error) {
 	return nil, nil
 }
 func (m *Dummymanager) GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*SystemInfo, error) {
 	return nil, nil
 }
-func (m *Dummymanager) SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string) {
+func (m *Dummymanager) SetResourceInfo(namespace string, resourcetype string, personality string, resourcename string, reconciled bool, required string) {
 
 }
-func (m *Dummymanager) GetStrategyRequiredList() map[string]*ResourceInfo {
+func (m *Dummymanager) GetStrategyRequiredList(namespace string) map[string]*ResourceInfo {
 	return m.Resource
 }
-func (m *Dummymanager) ListStrategyRequired() string {
+func (m *Dummymanager) ListStrategyRequired(namespace string) string {
 	return ""
 }
-func (m *Dummymanager) UpdateConfigVersion() {
+func (m *Dummymanager) UpdateConfigVersion(namespace string) {
 
 }
-func (m *Dummymanager) GetConfigVersion() int {
+func (m *Dummymanager) GetConfigVersion(namespace string) int {
 	return m.config_version
 }
-func (m *Dummymanager) GetMonitorVersion() int {
+func (m *Dummymanager) GetMonitorVersion(namespace string) int {
 	return m.monitor_version
 }
-func (m *Dummymanager) SetMonitorVersion(i int) {
+func (m *Dummymanager) SetMonitorVersion(namespace string, i int) {
 
 }
-func (m *Dummymanager) StrategySent() {
+func (m *Dummymanager) StrategySent(namespace string) {
 	m.strategySent = true
 }
-func (m *Dummymanager) GetStrategySent() bool {
+func (m *Dummymanager) GetStrategySent(namespace string) bool {
 	return m.strategySent
 }
-func (m *Dummymanager) ClearStrategy() {
+func (m *Dummymanager) ClearStrategy(namespace string) {
 
 }
-func (m *Dummymanager) GetNamespace() string {
-	return ""
-}
-func (m *Dummymanager) GetVimClient() *gophercloud.ServiceClient {
+func (m *Dummymanager) GetVimClient(namespace string) *gophercloud.ServiceClient {
 	if m.vimClientAvailable {
 		c := &gophercloud.ServiceClient{}
 		return c
@@ -135,15 +160,25 @@ func (m *Dummymanager) GetVimClient() *gophercloud.ServiceClient {
 func (m *Dummymanager) SetStrategyAppliedSent(namespace string, applied bool) error {
 	return nil
 }
-func (m *Dummymanager) StartStrategyMonitor() {
+func (m *Dummymanager) StartStrategyMonitor(namespace string) {
 
 }
-func (m *Dummymanager) SetStrategyRetryCount(c int) error {
+func (m *Dummymanager) SetStrategyRetryCount(namespace string, c int) error {
 	return nil
 }
-func (m *Dummymanager) GetStrategyRetryCount() (int, error) {
+func (m *Dummymanager) GetStrategyRetryCount(namespace string) (int, error) {
 	return m.retryCount, nil
 }
+func (m *Dummymanager) SetStrategyNextRetry(namespace string, next time.Time) {
+	m.nextRetry = next
+}
+func (m *Dummymanager) GetStrategyNextRetry(namespace string) time.Time {
+	return m.nextRetry
+}
+func (m *Dummymanager) RecordStrategyHistory(namespace string, entry starlingxv1.StrategyHistoryEntry) error {
+	m.strategyHistory, _ = AppendStrategyHistory(m.strategyHistory, m.strategyProgress, entry, DefaultStrategyHistoryLimit, metav1.Now())
+	return nil
+}
 func (m *Dummymanager) IsPlatformNetworkReconciling() bool {
 	return false
 }
@@ -156,12 +191,39 @@ func (m *Dummymanager) IsNotifyingActiveHost() bool {
 func (m *Dummymanager) SetNotifyingActiveHost(status bool) {
 
 }
-func (m *Dummymanager) SetStrategyExpectedByOtherReconcilers(status bool) {
+func (m *Dummymanager) SetStrategyExpectedByOtherReconcilers(namespace string, status bool) {
 
 }
-func (m *Dummymanager) GetStrategyExpectedByOtherReconcilers() bool {
+func (m *Dummymanager) GetStrategyExpectedByOtherReconcilers(namespace string) bool {
 	return false
 }
+func (m *Dummymanager) SetStrategyOptions(namespace string, options *starlingxv1.StrategyInfo) {
+	m.strategyOptions = options
+}
+func (m *Dummymanager) GetStrategyOptions(namespace string) starlingxv1.StrategyInfo {
+	return ResolveStrategyOptions(m.strategyOptions)
+}
+func (m *Dummymanager) UpdateStrategyProgress(namespace string, strategy *systemconfigupdate.SystemConfigUpdate, pendingApproval bool) error {
+	m.strategyProgress = NewStrategyProgress(m.strategyProgress, strategy, pendingApproval, metav1.Now())
+	return nil
+}
+func (m *Dummymanager) GetStrategyApproval(namespace string) (StrategyApproval, error) {
+	return m.strategyApproval, nil
+}
+func (m *Dummymanager) SetMaintenanceWindows(namespace string, info *starlingxv1.MaintenanceInfo) {
+	m.maintenance = info
+}
+func (m *Dummymanager) GetMaintenanceWindows(namespace string) *starlingxv1.MaintenanceInfo {
+	return m.maintenance
+}
+func (m *Dummymanager) UpdateMaintenancePending(namespace string, pending string, next *metav1.Time) error {
+	if pending == "" {
+		m.maintenancePending = nil
+	} else {
+		m.maintenancePending = &starlingxv1.MaintenanceWindowStatus{Pending: pending, NextWindow: next}
+	}
+	return nil
+}
 func (m *Dummymanager) GetHostByPersonality(namespace string, client *gophercloud.ServiceClient, personality string) (*starlingxv1.Host, *hosts.Host, error) {
 	if m.ActiveHost != nil {
 		return m.ActiveHost, nil, nil
@@ -185,6 +247,9 @@ func (m *Dummymanager) GcShow(c *gophercloud.ServiceClient) (*systemconfigupdate
 }
 func (m *Dummymanager) GcActionStrategy(c *gophercloud.ServiceClient, opts systemconfigupdate.StrategyActionOpts) (*systemconfigupdate.SystemConfigUpdate, error) {
 	m.strategyActionSend = true
+	if opts.Action != nil {
+		m.strategyAction = *opts.Action
+	}
 	if m.strategyActionError {
 		err := errors.New("test: action sent error")
 		return nil, err
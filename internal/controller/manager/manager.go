@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2024 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package manager
 
@@ -10,7 +10,9 @@ import (
 	"sync"
 
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/hosts"
@@ -18,10 +20,14 @@ import (
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	perrors "github.com/pkg/errors"
 	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -45,11 +51,16 @@ const (
 	// Defines annotation keys for resources.
 	NotificationCountKey = "deployment-manager/notifications"
 	ReconcileAfterInSync = "deployment-manager/reconcile-after-insync"
+	StrategyApproveKey   = "deployment-manager/strategy-approve"
+	StrategyAbortKey     = "deployment-manager/strategy-abort"
+	DryRunKey            = "deployment-manager/dry-run"
+	PauseKey             = "deployment-manager/paused"
+	DeploymentScopeKey   = v1.DeploymentScopeKey
 )
 
 const (
-	ScopeBootstrap = "bootstrap"
-	ScopePrincipal = "principal"
+	ScopeBootstrap = v1.ScopeBootstrap
+	ScopePrincipal = v1.ScopePrincipal
 )
 
 // TODO: Assign these consts in platform network controller instead.
@@ -97,6 +108,34 @@ const (
 	StrategyAborted      = "aborted"
 )
 
+// Event reasons and event source used to report strategy state transitions.
+const (
+	StrategyMonitorName        = "strategy-monitor"
+	StrategyStateChangedReason = "StrategyStateChanged"
+	StrategyFailedReason       = "StrategyFailed"
+
+	StrategyPendingApprovalReason = "StrategyPendingApproval"
+
+	StrategyRetriesExhaustedReason = "StrategyRetriesExhausted"
+)
+
+// DefaultStrategyHistoryLimit defines the maximum number of past strategies
+// kept in the System status.
+const DefaultStrategyHistoryLimit = 10
+
+// Event reasons and event source used to report monitors which exceed their
+// deadline.
+const (
+	MonitorName                   = "monitor"
+	MonitorDeadlineExceededReason = "MonitorDeadlineExceeded"
+)
+
+// Event reason used to report disruptive actions which are waiting for the
+// next maintenance window.
+const (
+	MaintenanceWindowClosedReason = "WaitingForMaintenanceWindow"
+)
+
 const (
 	NumDualStack = 2
 )
@@ -118,32 +157,44 @@ type CloudManager interface {
 	GetSystemType(namespace string) SystemType
 	StartMonitor(monitor *Monitor, message string) error
 	CancelMonitor(object client.Object)
+	ListMonitors() []MonitorDescription
+	SetDryRun(object client.Object, enabled bool)
+	GetEventRecorderFor(name string) record.EventRecorder
 	GetHostByPersonality(namespace string, client *gophercloud.ServiceClient, personality string) (*v1.Host, *hosts.Host, error)
 	GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*SystemInfo, error)
 
 	// Strategy related methods
-	SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string)
-	GetStrategyRequiredList() map[string]*ResourceInfo
-	ListStrategyRequired() string
-	UpdateConfigVersion()
-	GetConfigVersion() int
-	GetMonitorVersion() int
-	SetMonitorVersion(i int)
-	StrategySent()
-	GetStrategySent() bool
-	ClearStrategy()
-	GetNamespace() string
-	GetVimClient() *gophercloud.ServiceClient
+	SetResourceInfo(namespace string, resourcetype string, personality string, resourcename string, reconciled bool, required string)
+	GetStrategyRequiredList(namespace string) map[string]*ResourceInfo
+	ListStrategyRequired(namespace string) string
+	UpdateConfigVersion(namespace string)
+	GetConfigVersion(namespace string) int
+	GetMonitorVersion(namespace string) int
+	SetMonitorVersion(namespace string, i int)
+	StrategySent(namespace string)
+	GetStrategySent(namespace string) bool
+	ClearStrategy(namespace string)
+	GetVimClient(namespace string) *gophercloud.ServiceClient
 	SetStrategyAppliedSent(namespace string, applied bool) error
-	StartStrategyMonitor()
-	SetStrategyRetryCount(c int) error
-	GetStrategyRetryCount() (int, error)
+	StartStrategyMonitor(namespace string)
+	SetStrategyRetryCount(namespace string, c int) error
+	GetStrategyRetryCount(namespace string) (int, error)
+	SetStrategyNextRetry(namespace string, next time.Time)
+	GetStrategyNextRetry(namespace string) time.Time
+	RecordStrategyHistory(namespace string, entry v1.StrategyHistoryEntry) error
 	IsPlatformNetworkReconciling() bool
 	SetPlatformNetworkReconciling(status bool)
 	IsNotifyingActiveHost() bool
 	SetNotifyingActiveHost(status bool)
-	SetStrategyExpectedByOtherReconcilers(status bool)
-	GetStrategyExpectedByOtherReconcilers() bool
+	SetStrategyExpectedByOtherReconcilers(namespace string, status bool)
+	GetStrategyExpectedByOtherReconcilers(namespace string) bool
+	SetStrategyOptions(namespace string, options *v1.StrategyInfo)
+	GetStrategyOptions(namespace string) v1.StrategyInfo
+	UpdateStrategyProgress(namespace string, strategy *systemconfigupdate.SystemConfigUpdate, pendingApproval bool) error
+	GetStrategyApproval(namespace string) (StrategyApproval, error)
+	SetMaintenanceWindows(namespace string, info *v1.MaintenanceInfo)
+	GetMaintenanceWindows(namespace string) *v1.MaintenanceInfo
+	UpdateMaintenancePending(namespace string, pending string, next *metav1.Time) error
 	// factory install related methods
 	GetFactoryInstall(namespace string) (bool, error)
 	SetFactoryConfigFinalized(namespace string, value bool) error
@@ -174,9 +225,12 @@ const (
 )
 
 type SystemNamespace struct {
-	client     *gophercloud.ServiceClient
-	ready      bool
-	systemType SystemType
+	client      *gophercloud.ServiceClient
+	vimClient   *gophercloud.ServiceClient
+	ready       bool
+	systemType  SystemType
+	maintenance *v1.MaintenanceInfo
+	strategy    *StrategyStatus
 }
 
 type SystemInfo struct {
@@ -209,13 +263,19 @@ type ResourceInfo struct {
 	StrategyRequired string
 }
 
+// StrategyStatus tracks the configuration update strategy of a single system.
+// Each system namespace has its own instance so that the strategies of
+// several systems can be managed independently.
 type StrategyStatus struct {
-	ResourceInfo   map[string]*ResourceInfo
-	ConfigVersion  int
-	MonitorVersion int
-	StrategySent   bool
-	Namespace      string
-	MonitorStarted bool
+	ResourceInfo        map[string]*ResourceInfo
+	ConfigVersion       int
+	MonitorVersion      int
+	StrategySent        bool
+	Namespace           string
+	MonitorStarted      bool
+	Options             *v1.StrategyInfo
+	WaitForStrategySent bool
+	NextRetry           time.Time
 }
 
 type HostStrategyInfo struct {
@@ -228,11 +288,9 @@ type PlatformManager struct {
 	lock                            sync.Mutex
 	systems                         map[string]*SystemNamespace
 	monitors                        map[string]*Monitor
-	strategyStatus                  *StrategyStatus
-	vimClient                       *gophercloud.ServiceClient
+	dryRuns                         map[types.NamespacedName]bool
 	PlatformNetworkReconcilerStatus bool
 	NotifyActiveHostStatus          bool
-	WaitForStrategySent             bool
 	GetPlatformClientImpl           func(namespace string) *gophercloud.ServiceClient
 }
 
@@ -240,8 +298,9 @@ type RestoreStatus struct {
 	InSync *bool `json:"inSync"`
 }
 
-func NewStrategyStatus() *StrategyStatus {
+func NewStrategyStatus(namespace string) *StrategyStatus {
 	return &StrategyStatus{
+		Namespace:      namespace,
 		ResourceInfo:   make(map[string]*ResourceInfo),
 		ConfigVersion:  0,
 		MonitorVersion: 0,
@@ -252,10 +311,10 @@ func NewStrategyStatus() *StrategyStatus {
 
 func NewPlatformManager(manager manager.Manager) CloudManager {
 	return &PlatformManager{
-		Manager:        manager,
-		systems:        make(map[string]*SystemNamespace),
-		monitors:       make(map[string]*Monitor),
-		strategyStatus: NewStrategyStatus(),
+		Manager:  manager,
+		systems:  make(map[string]*SystemNamespace),
+		monitors: make(map[string]*Monitor),
+		dryRuns:  make(map[types.NamespacedName]bool),
 	}
 }
 
@@ -368,6 +427,338 @@ func (m *PlatformManager) SetStrategyAppliedSent(namespace string, applied bool)
 	return nil
 }
 
+// UpdateStrategyProgress records the progress of the strategy in the status
+// of the System resource and generates an event for each strategy state
+// transition.
+func (m *PlatformManager) UpdateStrategyProgress(namespace string, strategy *systemconfigupdate.SystemConfigUpdate, pendingApproval bool) error {
+	if namespace == "" {
+		log.V(2).Info("System namespace does not exist. Skip strategy progress update")
+		return nil
+	}
+
+	systems := &v1.SystemList{}
+	opts := client.ListOptions{}
+	opts.Namespace = namespace
+	err := m.GetClient().List(context.TODO(), systems, &opts)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to query system list")
+		return err
+	}
+
+	triggers := m.strategyTriggers(namespace)
+
+	for _, obj := range systems.Items {
+		previous := obj.Status.Strategy
+		progress := NewStrategyProgress(previous, strategy, pendingApproval, metav1.Now())
+		if len(progress.Triggers) == 0 {
+			progress.Triggers = triggers
+		}
+		metrics.SetStrategyState(namespace, progress.State)
+
+		if previous != nil && previous.DeepEqual(progress) {
+			continue
+		}
+
+		obj.Status.Strategy = progress
+
+		err = m.GetClient().Status().Update(context.TODO(), &obj)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to update system with strategy progress")
+			return err
+		}
+
+		recorder := m.GetEventRecorderFor(StrategyMonitorName)
+		if previous == nil || previous.ID != progress.ID || previous.State != progress.State {
+			if IsStrategyFailed(progress.State) {
+				recorder.Eventf(&obj, corev1.EventTypeWarning, StrategyFailedReason,
+					"strategy %s is %s: %s", progress.ID, progress.State, progress.FailureReason)
+			} else {
+				recorder.Eventf(&obj, corev1.EventTypeNormal, StrategyStateChangedReason,
+					"strategy %s is %s", progress.ID, progress.State)
+			}
+		}
+
+		if progress.PendingApproval && (previous == nil || !previous.PendingApproval) {
+			recorder.Eventf(&obj, corev1.EventTypeNormal, StrategyPendingApprovalReason,
+				"strategy %s is waiting for approval to apply stage %d", progress.ID, nextStrategyStage(strategy))
+		}
+
+		log.Info("Update strategy progress in System", "state", progress.State)
+	}
+
+	return nil
+}
+
+// NewStrategyTriggers returns the resources which require a strategy, sorted
+// by kind and name.
+func NewStrategyTriggers(resources map[string]*ResourceInfo) []v1.StrategyTrigger {
+	triggers := make([]v1.StrategyTrigger, 0)
+	for _, r := range resources {
+		if r.StrategyRequired == StrategyNotRequired {
+			continue
+		}
+
+		triggers = append(triggers, v1.StrategyTrigger{
+			Kind:             r.ResourceType,
+			Name:             r.Name,
+			Personality:      r.Personality,
+			StrategyRequired: r.StrategyRequired,
+		})
+	}
+
+	sort.Slice(triggers, func(i, j int) bool {
+		if triggers[i].Kind != triggers[j].Kind {
+			return triggers[i].Kind < triggers[j].Kind
+		}
+		return triggers[i].Name < triggers[j].Name
+	})
+
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	return triggers
+}
+
+// strategyTriggers returns the resources which currently require a strategy
+// in the specified namespace.
+func (m *PlatformManager) strategyTriggers(namespace string) []v1.StrategyTrigger {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	return NewStrategyTriggers(m.getStrategyStatus(namespace).ResourceInfo)
+}
+
+// AppendStrategyHistory adds an entry to a strategy history and drops the
+// oldest entries beyond the given limit.  The entry is completed with the
+// times, triggers and failure reason of the current strategy if it refers to
+// the same strategy.  It returns false if the entry was already recorded.
+func AppendStrategyHistory(history []v1.StrategyHistoryEntry, current *v1.SystemStrategyStatus, entry v1.StrategyHistoryEntry, limit int, now metav1.Time) ([]v1.StrategyHistoryEntry, bool) {
+	if entry.ID != "" && len(history) > 0 {
+		last := history[len(history)-1]
+		if last.ID == entry.ID && last.Outcome == entry.Outcome {
+			return history, false
+		}
+	}
+
+	if current != nil && entry.ID != "" && current.ID == entry.ID {
+		entry.StartTime = current.StartTime.DeepCopy()
+		entry.FinishTime = current.FinishTime.DeepCopy()
+		if len(entry.Triggers) == 0 {
+			entry.Triggers = current.Triggers
+		}
+		if entry.FailureReason == "" {
+			entry.FailureReason = current.FailureReason
+		}
+	}
+
+	if entry.FinishTime == nil {
+		entry.FinishTime = now.DeepCopy()
+	}
+
+	if entry.StartTime != nil {
+		entry.Duration = entry.FinishTime.Sub(entry.StartTime.Time).Round(time.Second).String()
+	}
+
+	history = append(history, entry)
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+
+	return history, true
+}
+
+// RecordStrategyHistory records the outcome of a strategy in the strategy
+// history of the System resource.  A warning event is generated if the
+// strategy was abandoned because its requests kept failing.
+func (m *PlatformManager) RecordStrategyHistory(namespace string, entry v1.StrategyHistoryEntry) error {
+	if namespace == "" {
+		return nil
+	}
+
+	if len(entry.Triggers) == 0 {
+		entry.Triggers = m.strategyTriggers(namespace)
+	}
+
+	systems := &v1.SystemList{}
+	opts := client.ListOptions{}
+	opts.Namespace = namespace
+	err := m.GetClient().List(context.TODO(), systems, &opts)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to query system list")
+		return err
+	}
+
+	for _, obj := range systems.Items {
+		history, added := AppendStrategyHistory(obj.Status.StrategyHistory, obj.Status.Strategy, entry, DefaultStrategyHistoryLimit, metav1.Now())
+		if !added {
+			continue
+		}
+
+		obj.Status.StrategyHistory = history
+
+		err = m.GetClient().Status().Update(context.TODO(), &obj)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to update system with strategy history")
+			return err
+		}
+
+		if entry.Outcome == StrategyRetriesExhausted {
+			m.GetEventRecorderFor(StrategyMonitorName).Event(&obj, corev1.EventTypeWarning,
+				StrategyRetriesExhaustedReason, entry.FailureReason)
+		}
+
+		log.Info("Update strategy history in System", "outcome", entry.Outcome)
+	}
+
+	return nil
+}
+
+// StrategyApproval defines the approval and abort requests given for a
+// strategy through the annotations of the System resource.  Requests only
+// apply to the strategy whose ID is given so that they are not mistakenly
+// applied to a strategy created later.
+type StrategyApproval struct {
+	// ApprovedID is the ID of the approved strategy.
+	ApprovedID string
+
+	// ApprovedStage is the last stage approved to be applied.  All stages
+	// are approved if it is not set.
+	ApprovedStage *int
+
+	// AbortID is the ID of the strategy to be aborted.
+	AbortID string
+}
+
+// IsApproved determines whether a stage of a strategy has been approved.
+func (in StrategyApproval) IsApproved(id string, stage int) bool {
+	if id == "" || in.ApprovedID != id {
+		return false
+	}
+
+	return in.ApprovedStage == nil || *in.ApprovedStage >= stage
+}
+
+// IsAbortRequested determines whether the strategy is to be aborted.
+func (in StrategyApproval) IsAbortRequested(id string) bool {
+	return id != "" && in.AbortID == id
+}
+
+// ParseStrategyApproval extracts the strategy approval from the annotations
+// of the System resource.  The approval annotation is set to the strategy ID
+// to approve all stages, or to "<id>/<stage>" to approve the stages up to and
+// including the one given.
+func ParseStrategyApproval(annotations map[string]string) (StrategyApproval, error) {
+	approval := StrategyApproval{AbortID: annotations[StrategyAbortKey]}
+
+	value := annotations[StrategyApproveKey]
+	id, stage, found := strings.Cut(value, "/")
+	approval.ApprovedID = id
+	if found {
+		n, err := strconv.Atoi(stage)
+		if err != nil || n < 0 {
+			return StrategyApproval{}, fmt.Errorf("invalid %s annotation stage: %q", StrategyApproveKey, value)
+		}
+		approval.ApprovedStage = &n
+	}
+
+	return approval, nil
+}
+
+// GetStrategyApproval returns the strategy approval given through the
+// annotations of the System resource.
+func (m *PlatformManager) GetStrategyApproval(namespace string) (StrategyApproval, error) {
+	if namespace == "" {
+		return StrategyApproval{}, nil
+	}
+
+	systems := &v1.SystemList{}
+	opts := client.ListOptions{}
+	opts.Namespace = namespace
+	err := m.GetClient().List(context.TODO(), systems, &opts)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to query system list")
+		return StrategyApproval{}, err
+	}
+
+	if len(systems.Items) == 0 {
+		return StrategyApproval{}, nil
+	}
+
+	return ParseStrategyApproval(systems.Items[0].Annotations)
+}
+
+// MaintenanceWindowOpen determines whether disruptive actions are allowed by
+// the maintenance windows at the given time.  If not then the time at which
+// the next window opens is returned if it is known.  Invalid windows are
+// treated as closed since they cannot be evaluated.
+func MaintenanceWindowOpen(info *v1.MaintenanceInfo, now time.Time) (bool, *metav1.Time) {
+	open, next, err := info.IsOpen(now)
+	if err != nil {
+		log.Error(err, "unable to evaluate maintenance windows")
+		return false, nil
+	}
+
+	if open || next.IsZero() {
+		return open, nil
+	}
+
+	return false, &metav1.Time{Time: next}
+}
+
+// UpdateMaintenancePending records the disruptive strategy action which is
+// waiting for the next maintenance window in the status of the System
+// resource and generates an event when it starts waiting.  An empty pending
+// action clears the status.
+func (m *PlatformManager) UpdateMaintenancePending(namespace string, pending string, next *metav1.Time) error {
+	if namespace == "" {
+		return nil
+	}
+
+	systems := &v1.SystemList{}
+	opts := client.ListOptions{}
+	opts.Namespace = namespace
+	err := m.GetClient().List(context.TODO(), systems, &opts)
+	if err != nil {
+		err = perrors.Wrap(err, "failed to query system list")
+		return err
+	}
+
+	for _, obj := range systems.Items {
+		var status *v1.MaintenanceWindowStatus
+		if pending != "" {
+			status = &v1.MaintenanceWindowStatus{Pending: pending, NextWindow: next}
+		}
+
+		previous := obj.Status.Maintenance
+		if previous == nil && status == nil {
+			continue
+		} else if previous != nil && status != nil && previous.DeepEqual(status) {
+			continue
+		}
+
+		obj.Status.Maintenance = status
+
+		err = m.GetClient().Status().Update(context.TODO(), &obj)
+		if err != nil {
+			err = perrors.Wrap(err, "failed to update system with maintenance status")
+			return err
+		}
+
+		if status != nil && (previous == nil || previous.Pending != status.Pending) {
+			msg := fmt.Sprintf("%s is waiting for the next maintenance window", pending)
+			if next != nil {
+				msg = fmt.Sprintf("%s at %s", msg, next.UTC().Format(time.RFC3339))
+			}
+			m.GetEventRecorderFor(StrategyMonitorName).Event(&obj, corev1.EventTypeNormal, MaintenanceWindowClosedReason, msg)
+		}
+
+		log.Info("Update maintenance status in System", "pending", pending)
+	}
+
+	return nil
+}
+
 // systemDependencies defines the list of controllers to be notified on a
 // system event.  Only those controllers that are managing external resources
 // need to be notified.  HostProfiles are consumed by Host resources therefore
@@ -537,6 +928,7 @@ func (m *PlatformManager) ResetPlatformClient(namespace string) error {
 			return nil
 		}
 		obj.client = nil
+		obj.vimClient = nil
 	} else {
 		// SystemNamespace doesn't exist yet
 		return nil
@@ -572,6 +964,32 @@ func (m *PlatformManager) GetSystemReady(namespace string) bool {
 	}
 }
 
+// SetMaintenanceWindows stores the maintenance windows configured on the
+// System resource of the specified namespace.
+func (m *PlatformManager) SetMaintenanceWindows(namespace string, info *v1.MaintenanceInfo) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; !ok {
+		m.systems[namespace] = &SystemNamespace{maintenance: info.DeepCopy()}
+	} else {
+		obj.maintenance = info.DeepCopy()
+	}
+}
+
+// GetMaintenanceWindows returns the maintenance windows configured on the
+// System resource of the specified namespace.
+func (m *PlatformManager) GetMaintenanceWindows(namespace string) *v1.MaintenanceInfo {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if obj, ok := m.systems[namespace]; ok {
+		return obj.maintenance.DeepCopy()
+	}
+
+	return nil
+}
+
 // SetSystemReady allows setting the readiness state for a given namespace.
 func (m *PlatformManager) SetSystemType(namespace string, value SystemType) {
 	m.lock.Lock()
@@ -608,7 +1026,16 @@ func (m *PlatformManager) StartMonitor(monitor *Monitor, message string) error {
 	defer func() { m.lock.Unlock() }()
 
 	key := monitor.GetKey()
+
+	if m.dryRuns[client.ObjectKeyFromObject(monitor.Object)] {
+		// The reconciler is only planning its requests therefore the state
+		// change that it would wait for is never going to happen.
+		log.V(2).Info("skipping monitor in dry-run mode", "key", key, "message", message)
+		return NewWaitForMonitor(message)
+	}
+
 	m.monitors[key] = monitor
+	monitor.message = message
 
 	log.V(2).Info("starting monitor", "key", key, "message", message)
 
@@ -634,6 +1061,49 @@ func (m *PlatformManager) CancelMonitor(object client.Object) {
 	}
 }
 
+// SetDryRun marks whether a resource is currently being reconciled in dry-run
+// mode.  While marked, monitors are not started on behalf of the resource and
+// its strategy requirements are not recorded so that planning a change never
+// triggers an actual update strategy.
+func (m *PlatformManager) SetDryRun(object client.Object, enabled bool) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	key := client.ObjectKeyFromObject(object)
+	if enabled {
+		m.dryRuns[key] = true
+	} else {
+		delete(m.dryRuns, key)
+	}
+}
+
+// ListMonitors returns a description of each active monitor ordered by the
+// namespace and name of the object being monitored.
+func (m *PlatformManager) ListMonitors() []MonitorDescription {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	result := make([]MonitorDescription, 0, len(m.monitors))
+	for _, monitor := range m.monitors {
+		if !monitor.IsRunning() {
+			continue
+		}
+		result = append(result, monitor.Describe())
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Type < result[j].Type
+	})
+
+	return result
+}
+
 func (m *PlatformManager) GetSystemInfo(namespace string, client *gophercloud.ServiceClient) (*SystemInfo, error) {
 	system_info := &SystemInfo{}
 
@@ -690,31 +1160,72 @@ func (m *PlatformManager) GetHostByPersonality(namespace string, client *gopherc
 	return host_instance, host_obj, nil
 }
 
-func (m *PlatformManager) StartStrategyMonitor() {
-	if !m.strategyStatus.MonitorStarted {
-		log.Info("Start strategy monitor")
-		go StrategyRequiredMonitor(instance)
-		m.strategyStatus.MonitorStarted = true
+// getSystemNamespace returns the state kept for the system of the specified
+// namespace, creating it if necessary.  The caller must hold the lock.
+func (m *PlatformManager) getSystemNamespace(namespace string) *SystemNamespace {
+	obj, ok := m.systems[namespace]
+	if !ok {
+		obj = &SystemNamespace{}
+		m.systems[namespace] = obj
+	}
+
+	if obj.strategy == nil {
+		obj.strategy = NewStrategyStatus(namespace)
+	}
+
+	return obj
+}
+
+// getStrategyStatus returns the strategy status of the specified namespace.
+// The caller must hold the lock.
+func (m *PlatformManager) getStrategyStatus(namespace string) *StrategyStatus {
+	return m.getSystemNamespace(namespace).strategy
+}
+
+// startStrategyMonitor starts the strategy monitor of the specified namespace
+// unless it is already running.  The caller must hold the lock.
+func (m *PlatformManager) startStrategyMonitor(namespace string) {
+	status := m.getStrategyStatus(namespace)
+	if !status.MonitorStarted {
+		log.Info("Start strategy monitor", "namespace", namespace)
+		go StrategyRequiredMonitor(m, namespace)
+		status.MonitorStarted = true
 	}
 }
 
+// StartStrategyMonitor starts the strategy monitor of the specified namespace
+// unless it is already running.
+func (m *PlatformManager) StartStrategyMonitor(namespace string) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	m.startStrategyMonitor(namespace)
+}
+
 // SetResourceInfo to store strategy required values of each resources
-func (m *PlatformManager) SetResourceInfo(resourcetype string, personality string, resourcename string, reconciled bool, required string) {
+func (m *PlatformManager) SetResourceInfo(namespace string, resourcetype string, personality string, resourcename string, reconciled bool, required string) {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
+	if m.dryRuns[types.NamespacedName{Namespace: namespace, Name: resourcename}] {
+		log.V(2).Info("Resource Info is ignored in dry-run mode", "Namespace", namespace, "Resource Name", resourcename)
+		return
+	}
+
+	status := m.getStrategyStatus(namespace)
+
 	// If this is the first resource information, start strategy monitor
-	if len(m.strategyStatus.ResourceInfo) == 0 {
-		m.StartStrategyMonitor()
+	if len(status.ResourceInfo) == 0 {
+		m.startStrategyMonitor(namespace)
 	}
 
-	info, ok := m.strategyStatus.ResourceInfo[resourcename]
+	info, ok := status.ResourceInfo[resourcename]
 	if ok {
 		info.ResourceType = resourcetype
 		info.Personality = personality
 		info.Reconciled = reconciled
 		info.StrategyRequired = required
-		log.Info("Resource Info is updated", "Personality", personality, "Resource Name", resourcename, "Reconciled", reconciled, "Strategy Required", required)
+		log.Info("Resource Info is updated", "Namespace", namespace, "Personality", personality, "Resource Name", resourcename, "Reconciled", reconciled, "Strategy Required", required)
 	} else {
 		info = &ResourceInfo{
 			ResourceType:     resourcetype,
@@ -723,25 +1234,43 @@ func (m *PlatformManager) SetResourceInfo(resourcetype string, personality strin
 			Reconciled:       reconciled,
 			StrategyRequired: required,
 		}
-		m.strategyStatus.ResourceInfo[resourcename] = info
-		log.Info("Resource Info is added", "Personality", personality, "Resource Name", resourcename, "Reconciled", reconciled, "Strategy Required", required)
+		status.ResourceInfo[resourcename] = info
+		log.Info("Resource Info is added", "Namespace", namespace, "Personality", personality, "Resource Name", resourcename, "Reconciled", reconciled, "Strategy Required", required)
 	}
 }
 
+// SetStrategyOptions stores the strategy options configured on the System
+// resource so that they can be used by the strategy monitor.
+func (m *PlatformManager) SetStrategyOptions(namespace string, options *v1.StrategyInfo) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	m.getStrategyStatus(namespace).Options = options.DeepCopy()
+}
+
+// GetStrategyOptions returns the strategy options with any option which is
+// not configured on the System resource set to its default value.
+func (m *PlatformManager) GetStrategyOptions(namespace string) v1.StrategyInfo {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	return ResolveStrategyOptions(m.getStrategyStatus(namespace).Options)
+}
+
 // GetStrategyRequiredList returns the current strategy required list
-func (m *PlatformManager) GetStrategyRequiredList() map[string]*ResourceInfo {
+func (m *PlatformManager) GetStrategyRequiredList(namespace string) map[string]*ResourceInfo {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	return m.strategyStatus.ResourceInfo
+	return m.getStrategyStatus(namespace).ResourceInfo
 }
 
 // ListStrategyRequired returns the current StrategyStatus in json format string
-func (m *PlatformManager) ListStrategyRequired() string {
+func (m *PlatformManager) ListStrategyRequired(namespace string) string {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	data, err := json.Marshal(m.strategyStatus)
+	data, err := json.Marshal(m.getStrategyStatus(namespace))
 	if err != nil {
 		return "erro in marshal"
 	}
@@ -751,62 +1280,63 @@ func (m *PlatformManager) ListStrategyRequired() string {
 }
 
 // UpdateConfigVersion to increase configuration version
-func (m *PlatformManager) UpdateConfigVersion() {
+func (m *PlatformManager) UpdateConfigVersion(namespace string) {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	current_version := m.strategyStatus.ConfigVersion
-	m.strategyStatus.ConfigVersion++
-	log.Info("Config version is updated", "from", current_version, "to", m.strategyStatus.ConfigVersion)
+	status := m.getStrategyStatus(namespace)
+	current_version := status.ConfigVersion
+	status.ConfigVersion++
+	log.Info("Config version is updated", "namespace", namespace, "from", current_version, "to", status.ConfigVersion)
 }
 
 // GetConfigVersion to return the current configuration version
-func (m *PlatformManager) GetConfigVersion() int {
+func (m *PlatformManager) GetConfigVersion(namespace string) int {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	return m.strategyStatus.ConfigVersion
+	return m.getStrategyStatus(namespace).ConfigVersion
 }
 
 // GetMonitorVersion to return monitor version
-func (m *PlatformManager) GetMonitorVersion() int {
+func (m *PlatformManager) GetMonitorVersion(namespace string) int {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	return m.strategyStatus.MonitorVersion
+	return m.getStrategyStatus(namespace).MonitorVersion
 }
 
 // GetMonitorVersion to set monitor version
-func (m *PlatformManager) SetMonitorVersion(i int) {
+func (m *PlatformManager) SetMonitorVersion(namespace string, i int) {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	m.strategyStatus.MonitorVersion = i
+	m.getStrategyStatus(namespace).MonitorVersion = i
 }
 
 // StrategySent to update strategy sent with true
-func (m *PlatformManager) StrategySent() {
+func (m *PlatformManager) StrategySent(namespace string) {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	m.strategyStatus.StrategySent = true
+	m.getStrategyStatus(namespace).StrategySent = true
 }
 
 // GetStrategySent to return the current strategy sent value
-func (m *PlatformManager) GetStrategySent() bool {
+func (m *PlatformManager) GetStrategySent(namespace string) bool {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	return m.strategyStatus.StrategySent
+	return m.getStrategyStatus(namespace).StrategySent
 }
 
 // GetStrategyRetryCount to return strategy retry count value
-func (m *PlatformManager) GetStrategyRetryCount() (int, error) {
+func (m *PlatformManager) GetStrategyRetryCount(namespace string) (int, error) {
 	count := 0
 
 	systems := &v1.SystemList{}
 	opts := client.ListOptions{}
-	opts.Namespace = m.strategyStatus.Namespace
+	opts.Namespace = namespace
 	err := m.GetClient().List(context.TODO(), systems, &opts)
 	if err != nil {
 		err = perrors.Wrap(err, "failed to query system list")
@@ -822,12 +1352,12 @@ func (m *PlatformManager) GetStrategyRetryCount() (int, error) {
 }
 
 // SetStrategyRetryCount to set strategy retry count value
-func (m *PlatformManager) SetStrategyRetryCount(c int) error {
+func (m *PlatformManager) SetStrategyRetryCount(namespace string, c int) error {
 	// Update the same value in System resources in case of
 	// DM container failure
 	systems := &v1.SystemList{}
 	opts := client.ListOptions{}
-	opts.Namespace = m.strategyStatus.Namespace
+	opts.Namespace = namespace
 	err := m.GetClient().List(context.TODO(), systems, &opts)
 	if err != nil {
 		err = perrors.Wrap(err, "failed to query system list")
@@ -862,45 +1392,74 @@ func (m *PlatformManager) SetStrategyRetryCount(c int) error {
 }
 
 // ClearStrategy to clear strategy status
-func (m *PlatformManager) ClearStrategy() {
+func (m *PlatformManager) ClearStrategy(namespace string) {
 	// Clear applied status in System instance
-	err := m.SetStrategyAppliedSent(m.strategyStatus.Namespace, false)
+	err := m.SetStrategyAppliedSent(namespace, false)
 	if err != nil {
 		log.Error(err, "Set strategy applied sent false error")
 	}
-	m.strategyStatus = NewStrategyStatus()
+
+	// The strategy options are configured on the System resource and are
+	// retained for the next strategy.
+	m.lock.Lock()
+	obj := m.getSystemNamespace(namespace)
+	options := obj.strategy.Options
+	obj.strategy = NewStrategyStatus(namespace)
+	obj.strategy.Options = options
+	m.lock.Unlock()
 
 	// Reset strategy retry count
-	err = m.SetStrategyRetryCount(0)
+	err = m.SetStrategyRetryCount(namespace, 0)
 	if err != nil {
 		log.Error(err, "Set strategy retry count clear failure")
 	}
 }
 
-// GetNamespace returns namespace for gopher client
-func (m *PlatformManager) GetNamespace() string {
+// SetStrategyNextRetry sets the time before which a failed strategy request
+// is not retried.
+func (m *PlatformManager) SetStrategyNextRetry(namespace string, next time.Time) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	m.getStrategyStatus(namespace).NextRetry = next
+}
+
+// GetStrategyNextRetry returns the time before which a failed strategy
+// request is not retried.
+func (m *PlatformManager) GetStrategyNextRetry(namespace string) time.Time {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
 
-	return m.strategyStatus.Namespace
+	return m.getStrategyStatus(namespace).NextRetry
 }
 
 // GetVimClient returns vim client for system update
-func (m *PlatformManager) GetVimClient() *gophercloud.ServiceClient {
-	if m.vimClient == nil {
-		namespace := m.GetNamespace()
-		if namespace == "" {
-			log.Info("No Namespace. Waiting for platform client creation")
-		} else {
-			c, err := m.BuildPlatformClient(namespace, VimEndpointName, VimEndpointType)
-			if err != nil {
-				log.Error(err, "Create client failed")
-			} else {
-				m.vimClient = c
-			}
-		}
+func (m *PlatformManager) GetVimClient(namespace string) *gophercloud.ServiceClient {
+	m.lock.Lock()
+	obj := m.getSystemNamespace(namespace)
+	c := obj.vimClient
+	m.lock.Unlock()
+
+	if c != nil {
+		return c
+	}
+
+	if namespace == "" {
+		log.Info("No Namespace. Waiting for platform client creation")
+		return nil
+	}
+
+	c, err := m.BuildPlatformClient(namespace, VimEndpointName, VimEndpointType)
+	if err != nil {
+		log.Error(err, "Create client failed", "namespace", namespace)
+		return nil
 	}
-	return m.vimClient
+
+	m.lock.Lock()
+	obj.vimClient = c
+	m.lock.Unlock()
+
+	return c
 }
 
 func (m *PlatformManager) IsPlatformNetworkReconciling() bool {
@@ -927,10 +1486,10 @@ func (m *PlatformManager) SetNotifyingActiveHost(status bool) {
 	m.NotifyActiveHostStatus = status
 }
 
-func (m *PlatformManager) SetStrategyExpectedByOtherReconcilers(status bool) {
+func (m *PlatformManager) SetStrategyExpectedByOtherReconcilers(namespace string, status bool) {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
-	m.WaitForStrategySent = status
+	m.getStrategyStatus(namespace).WaitForStrategySent = status
 }
 
 // WaitForStrategySent is used to indicate whether a strategy creation
@@ -940,10 +1499,10 @@ func (m *PlatformManager) SetStrategyExpectedByOtherReconcilers(status bool) {
 // Returns
 // true: When strategy request is expected to be sent
 // false: When strategy request is sent / there is no expectation of sending it.
-func (m *PlatformManager) GetStrategyExpectedByOtherReconcilers() bool {
+func (m *PlatformManager) GetStrategyExpectedByOtherReconcilers(namespace string) bool {
 	m.lock.Lock()
 	defer func() { m.lock.Unlock() }()
-	return m.WaitForStrategySent
+	return m.getStrategyStatus(namespace).WaitForStrategySent
 }
 
 // GcCreate is wrapper function for systemconfigupdate Create
@@ -4,8 +4,17 @@
 package manager
 
 import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Manager utils", func() {
@@ -38,4 +47,177 @@ var _ = Describe("Manager utils", func() {
 			})
 		})
 	})
+	Describe("Function ParseStrategyApproval", func() {
+		Context("with approval annotations", func() {
+			It("should return the approved strategy and stage", func() {
+				got, err := ParseStrategyApproval(map[string]string{StrategyApproveKey: "abc-def"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got.IsApproved("abc-def", 5)).To(BeTrue())
+				Expect(got.IsApproved("ghi-jkl", 0)).To(BeFalse())
+
+				got, err = ParseStrategyApproval(map[string]string{StrategyApproveKey: "abc-def/1", StrategyAbortKey: "abc-def"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got.IsApproved("abc-def", 1)).To(BeTrue())
+				Expect(got.IsApproved("abc-def", 2)).To(BeFalse())
+				Expect(got.IsAbortRequested("abc-def")).To(BeTrue())
+
+				_, err = ParseStrategyApproval(map[string]string{StrategyApproveKey: "abc-def/next"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("without approval annotations", func() {
+			It("should not approve or abort any strategy", func() {
+				got, err := ParseStrategyApproval(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got.IsApproved("", 0)).To(BeFalse())
+				Expect(got.IsAbortRequested("")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Per-namespace strategy state", func() {
+		Context("with several systems", func() {
+			It("should track each strategy independently", func() {
+				m := &PlatformManager{systems: make(map[string]*SystemNamespace)}
+				mode := v1.StrategyApprovalManual
+
+				m.UpdateConfigVersion("system-a")
+				m.UpdateConfigVersion("system-a")
+				m.UpdateConfigVersion("system-b")
+				m.StrategySent("system-b")
+				m.SetStrategyExpectedByOtherReconcilers("system-a", true)
+				m.SetStrategyOptions("system-b", &v1.StrategyInfo{ApprovalMode: &mode})
+
+				Expect(m.GetConfigVersion("system-a")).To(Equal(2))
+				Expect(m.GetConfigVersion("system-b")).To(Equal(1))
+				Expect(m.GetStrategySent("system-a")).To(BeFalse())
+				Expect(m.GetStrategySent("system-b")).To(BeTrue())
+				Expect(m.GetStrategyExpectedByOtherReconcilers("system-a")).To(BeTrue())
+				Expect(m.GetStrategyExpectedByOtherReconcilers("system-b")).To(BeFalse())
+				Expect(*m.GetStrategyOptions("system-a").ApprovalMode).To(Equal(DefaultStrategyApprovalMode))
+				Expect(*m.GetStrategyOptions("system-b").ApprovalMode).To(Equal(v1.StrategyApprovalManual))
+			})
+		})
+	})
+
+	Describe("Dry-run mode", func() {
+		Context("while a resource is marked", func() {
+			It("should neither start monitors nor record strategy requirements", func() {
+				m := &PlatformManager{
+					systems:  make(map[string]*SystemNamespace),
+					monitors: make(map[string]*Monitor),
+					dryRuns:  make(map[types.NamespacedName]bool),
+				}
+				host := &v1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "default", UID: "1234"}}
+
+				m.SetDryRun(host, true)
+				Expect(m.dryRuns).To(HaveKey(types.NamespacedName{Namespace: "default", Name: "controller-0"}))
+
+				monitor := &Monitor{MonitorBody: &testMonitorBody{}, Logger: logr.Discard(), Object: host}
+				Expect(m.StartMonitor(monitor, "waiting for host")).To(MatchError("waiting for host"))
+				Expect(m.monitors).To(BeEmpty())
+
+				m.SetResourceInfo("default", ResourceHost, "controller", "controller-0", false, StrategyLockRequired)
+				Expect(m.GetStrategyRequiredList("default")).To(BeEmpty())
+
+				m.SetDryRun(host, false)
+				Expect(m.dryRuns).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Function IsPaused", func() {
+		Context("with the pause annotation", func() {
+			It("should report whether the resource or its system is paused", func() {
+				scheme := runtime.NewScheme()
+				Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+				system := &v1.System{ObjectMeta: metav1.ObjectMeta{Name: "system", Namespace: "default"}}
+				host := &v1.Host{ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: "default"}}
+				c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(system).Build()
+
+				paused, _, err := IsPaused(c, host)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paused).To(BeFalse())
+
+				host.Annotations = map[string]string{PauseKey: "true"}
+				paused, reason, err := IsPaused(c, host)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paused).To(BeTrue())
+				Expect(reason).To(Equal(v1.ReasonPauseRequested))
+
+				host.Annotations = nil
+				system.Annotations = map[string]string{PauseKey: "true"}
+				Expect(c.Update(context.TODO(), system)).To(Succeed())
+				paused, reason, err = IsPaused(c, host)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paused).To(BeTrue())
+				Expect(reason).To(Equal(v1.ReasonSystemPaused))
+
+				paused, err = IsNamespacePaused(c, "other")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paused).To(BeFalse())
+				Expect(ManageStrategy(&Dummymanager{Client: c}, "default")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Function NewStrategyTriggers", func() {
+		Context("with resources requiring a strategy", func() {
+			It("should only return those resources sorted by kind and name", func() {
+				resources := map[string]*ResourceInfo{
+					"worker-1":     {ResourceType: ResourceHost, Name: "worker-1", Personality: PersonalityWorker, StrategyRequired: StrategyLockRequired},
+					"controller-0": {ResourceType: ResourceHost, Name: "controller-0", Personality: PersonalityController, StrategyRequired: StrategyLockRequired},
+					"worker-0":     {ResourceType: ResourceHost, Name: "worker-0", StrategyRequired: StrategyNotRequired},
+					"vbox":         {ResourceType: ResourceSystem, Name: "vbox", StrategyRequired: StrategyUnlockRequired},
+				}
+				got := NewStrategyTriggers(resources)
+				Expect(got).To(HaveLen(3))
+				Expect(got[0].Name).To(Equal("controller-0"))
+				Expect(got[1].Name).To(Equal("worker-1"))
+				Expect(got[2].Kind).To(Equal(ResourceSystem))
+			})
+		})
+		Context("with no resources requiring a strategy", func() {
+			It("should return nil", func() {
+				Expect(NewStrategyTriggers(map[string]*ResourceInfo{})).To(BeNil())
+			})
+		})
+	})
+	Describe("Function AppendStrategyHistory", func() {
+		start := metav1.NewTime(time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC))
+		finish := metav1.NewTime(start.Add(90 * time.Second))
+
+		Context("with the current strategy", func() {
+			It("should complete the entry from the strategy status", func() {
+				current := &v1.SystemStrategyStatus{
+					ID:            "abc",
+					StartTime:     &start,
+					FinishTime:    &finish,
+					FailureReason: "host failed to lock",
+					Triggers:      []v1.StrategyTrigger{{Kind: ResourceHost, Name: "controller-0"}},
+				}
+				got, added := AppendStrategyHistory(nil, current, v1.StrategyHistoryEntry{ID: "abc", Outcome: "apply-failed"}, 3, finish)
+				Expect(added).To(BeTrue())
+				Expect(got).To(HaveLen(1))
+				Expect(got[0].Duration).To(Equal("1m30s"))
+				Expect(got[0].FailureReason).To(Equal("host failed to lock"))
+				Expect(got[0].Triggers).To(HaveLen(1))
+
+				_, added = AppendStrategyHistory(got, current, v1.StrategyHistoryEntry{ID: "abc", Outcome: "apply-failed"}, 3, finish)
+				Expect(added).To(BeFalse())
+			})
+		})
+		Context("when the history is full", func() {
+			It("should drop the oldest entries", func() {
+				var history []v1.StrategyHistoryEntry
+				for _, id := range []string{"a", "b", "c", "d"} {
+					history, _ = AppendStrategyHistory(history, nil, v1.StrategyHistoryEntry{ID: id, Outcome: "applied"}, 3, finish)
+				}
+				Expect(history).To(HaveLen(3))
+				Expect(history[0].ID).To(Equal("b"))
+				Expect(history[2].ID).To(Equal("d"))
+			})
+		})
+	})
 })
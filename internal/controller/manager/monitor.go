@@ -1,10 +1,14 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2023 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package manager
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
 
 	"time"
 
@@ -12,8 +16,14 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
 	"github.com/pkg/errors"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	common "github.com/wind-river/cloud-platform-deployment-manager/common"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
+	corev1 "k8s.io/api/core/v1"
 	errors2 "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -76,9 +86,83 @@ type Monitor struct {
 	// monitoring event.
 	Object client.Object
 
+	// Deadline defines how long the monitor may wait before the resource is
+	// reported as degraded.  If not set, the deadline configured for the
+	// monitor type is used; otherwise the monitor waits indefinitely.
+	Deadline time.Duration
+
+	// RetryOnDeadline defines whether the monitor stops and notifies the
+	// reconciler once the deadline has passed so that the action can be
+	// retried.  If not set, the value configured for the monitor type is used.
+	RetryOnDeadline bool
+
 	// stopCh is the stop channel indirectly used by the caller to stop this
 	// monitor from running.
 	stopCh chan struct{}
+
+	// lock protects the runtime information below since it is written by the
+	// monitor Go routine and read by the debug endpoint.
+	lock sync.Mutex
+
+	// running indicates whether the monitor Go routine is still running.
+	running bool
+
+	// message is the reason given by the reconciler when starting the
+	// monitor.  It is reported until the monitor body reports its own state.
+	message string
+
+	// startTime is the time at which the monitor was started.
+	startTime time.Time
+
+	// lastRun is the time of the last polling attempt.
+	lastRun time.Time
+
+	// state is the state reported by the monitor body on the last polling
+	// attempt.
+	state string
+
+	// lastError is the error returned by the last polling attempt.
+	lastError string
+
+	// expired indicates that the deadline has passed.
+	expired bool
+
+	// published is the monitor status last written to the monitored object.
+	published *v1.MonitorStatus
+}
+
+// MonitorStatusInstance defines the interface implemented by resources which
+// report the monitor being waited on in their status.
+type MonitorStatusInstance interface {
+	client.Object
+	SetStatusMonitor(monitor *v1.MonitorStatus)
+	GetStatusMonitor() *v1.MonitorStatus
+}
+
+// monitorConditionsInstance defines the interface implemented by resources
+// which report the standard conditions.
+type monitorConditionsInstance interface {
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// MonitorDescription describes an active monitor.  It is reported by the
+// monitor debug endpoint.
+type MonitorDescription struct {
+	Key       string     `json:"key"`
+	Type      string     `json:"type"`
+	Kind      string     `json:"kind"`
+	Namespace string     `json:"namespace"`
+	Name      string     `json:"name"`
+	Interval  string     `json:"interval"`
+	StartTime time.Time  `json:"startTime"`
+	LastRun   *time.Time `json:"lastRun,omitempty"`
+	State     string     `json:"state,omitempty"`
+	LastError string     `json:"lastError,omitempty"`
+	Summary   string     `json:"summary"`
+
+	Deadline         string `json:"deadline,omitempty"`
+	DeadlineExceeded bool   `json:"deadlineExceeded,omitempty"`
 }
 
 // BuildMonitorKey is a utility function that formats a string to be used
@@ -110,6 +194,21 @@ func (m *Monitor) GetKey() string {
 	return BuildMonitorKey(m.Object)
 }
 
+// GetType returns the type name of the monitor body so that monitors can be
+// distinguished in metrics.
+func (m *Monitor) GetType() string {
+	t := reflect.TypeOf(m.MonitorBody)
+	if t == nil {
+		return "unknown"
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
 // GetNamespace returns the namespace to which the object being monitored is
 // associated.
 func (m *Monitor) GetNamespace() string {
@@ -123,6 +222,233 @@ func (m *Monitor) GetNamespace() string {
 	return namespace
 }
 
+// GetKind returns the type name of the object being monitored.  The GVK of
+// the object is not always populated therefore the Go type is used instead.
+func (m *Monitor) GetKind() string {
+	t := reflect.TypeOf(m.Object)
+	if t == nil {
+		return "unknown"
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// currentState returns the last state reported by the monitor body or the
+// message supplied by the reconciler if the monitor has not run yet.  The
+// caller must hold the lock.
+func (m *Monitor) currentState() string {
+	if m.state != "" {
+		return m.state
+	}
+
+	return m.message
+}
+
+// IsRunning returns whether the monitor Go routine is still running.
+func (m *Monitor) IsRunning() bool {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	return m.running
+}
+
+// setStopped records that the monitor Go routine has exited.
+func (m *Monitor) setStopped() {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	m.running = false
+}
+
+// Describe returns the runtime information of the monitor.
+func (m *Monitor) Describe() MonitorDescription {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	result := MonitorDescription{
+		Key:       m.GetKey(),
+		Type:      m.GetType(),
+		Kind:      m.GetKind(),
+		Namespace: m.GetNamespace(),
+		Name:      m.Object.GetName(),
+		Interval:  m.Interval.String(),
+		StartTime: m.startTime,
+		State:     m.currentState(),
+		LastError: m.lastError,
+
+		DeadlineExceeded: m.expired,
+	}
+
+	if m.Deadline > 0 {
+		result.Deadline = m.Deadline.String()
+	}
+
+	if !m.lastRun.IsZero() {
+		lastRun := m.lastRun
+		result.LastRun = &lastRun
+	}
+
+	result.Summary = fmt.Sprintf("%s since %s", result.State,
+		duration.HumanDuration(time.Since(m.startTime)))
+
+	return result
+}
+
+// record saves the outcome of a polling attempt.
+func (m *Monitor) record(err error) {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	m.lastRun = time.Now()
+	m.state = m.State()
+	m.lastError = ""
+	if err != nil {
+		m.lastError = err.Error()
+	}
+}
+
+// buildStatus returns the monitor status to be reported by the monitored
+// object.
+func (m *Monitor) buildStatus() *v1.MonitorStatus {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	startTime := metav1.NewTime(m.startTime)
+
+	result := &v1.MonitorStatus{
+		Type:             m.GetType(),
+		Message:          m.currentState(),
+		Interval:         m.Interval.String(),
+		StartTime:        &startTime,
+		DeadlineExceeded: m.expired,
+		LastError:        m.lastError,
+	}
+
+	if m.Deadline > 0 {
+		result.Deadline = m.Deadline.String()
+	}
+
+	return result
+}
+
+// patchStatus reads the latest version of the monitored object, applies the
+// update function to it, and writes its status back.  A merge patch is used
+// so that only the fields changed by the update function are written and the
+// resource version of the object is not checked.
+func (m *Monitor) patchStatus(update func(latest client.Object)) error {
+	if m.Manager == nil {
+		return nil
+	}
+
+	k8sClient := m.Manager.GetKubernetesClient()
+	if k8sClient == nil {
+		return nil
+	}
+
+	latest, ok := m.Object.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil
+	}
+
+	err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(m.Object), latest)
+	if err != nil {
+		return err
+	}
+
+	original, ok := latest.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil
+	}
+
+	update(latest)
+
+	return k8sClient.Status().Patch(context.TODO(), latest, client.MergeFrom(original))
+}
+
+// publishStatus writes the monitor status to the status of the monitored
+// object if it has changed since it was last written.  A nil status clears
+// the monitor status.  Failures are only logged since the status is purely
+// informational.
+func (m *Monitor) publishStatus(status *v1.MonitorStatus) {
+	if _, ok := m.Object.(MonitorStatusInstance); !ok {
+		return
+	}
+
+	if (status == nil && m.published == nil) || (status != nil && status.DeepEqual(m.published)) {
+		return
+	}
+
+	err := m.patchStatus(func(latest client.Object) {
+		latest.(MonitorStatusInstance).SetStatusMonitor(status)
+	})
+	if err != nil {
+		m.V(2).Info("failed to update monitor status", "error", err.Error())
+		return
+	}
+
+	m.published = status
+}
+
+// checkDeadline returns true the first time that the monitor is found to be
+// still running after its deadline.
+func (m *Monitor) checkDeadline() bool {
+	m.lock.Lock()
+	defer func() { m.lock.Unlock() }()
+
+	if m.Deadline <= 0 || m.expired || time.Since(m.startTime) < m.Deadline {
+		return false
+	}
+
+	m.expired = true
+
+	return true
+}
+
+// escalate reports that the monitor has exceeded its deadline by generating a
+// warning event and setting the Degraded condition of the monitored object.
+func (m *Monitor) escalate() {
+	m.lock.Lock()
+	message := fmt.Sprintf("%s did not complete within %s: %s",
+		m.GetType(), m.Deadline, m.currentState())
+	m.lock.Unlock()
+
+	m.Info("monitor deadline exceeded", "key", m.GetKey(), "message", message)
+
+	metrics.MonitorDeadlinesExceeded.WithLabelValues(m.GetType()).Inc()
+
+	if m.Manager == nil {
+		return
+	}
+
+	if recorder := m.Manager.GetEventRecorderFor(MonitorName); recorder != nil {
+		recorder.Event(m.Object, corev1.EventTypeWarning, MonitorDeadlineExceededReason, message)
+	}
+
+	if _, ok := m.Object.(monitorConditionsInstance); !ok {
+		return
+	}
+
+	err := m.patchStatus(func(latest client.Object) {
+		obj := latest.(monitorConditionsInstance)
+		conditions := append([]metav1.Condition{}, obj.GetConditions()...)
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               v1.ConditionDegraded,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: latest.GetGeneration(),
+			Reason:             v1.ReasonMonitorDeadlineExceeded,
+			Message:            message,
+		})
+		obj.SetConditions(conditions)
+	})
+	if err != nil {
+		m.V(2).Info("failed to update degraded condition", "error", err.Error())
+	}
+}
+
 // Start is responsible for stating the Go routine that will monitor a resource
 // or set of resources.
 func (m *Monitor) Start(manager CloudManager) {
@@ -135,7 +461,25 @@ func (m *Monitor) Start(manager CloudManager) {
 	m.Manager = manager
 	m.stopCh = make(chan struct{})
 
+	if m.Deadline == 0 {
+		m.Deadline = common.GetMonitorOptionDuration(m.GetType(), common.MonitorDeadline, 0)
+	}
+
+	if !m.RetryOnDeadline {
+		m.RetryOnDeadline = common.GetMonitorOptionBool(m.GetType(), common.MonitorRetryOnDeadline, false)
+	}
+
+	m.lock.Lock()
+	m.startTime = time.Now()
+	m.running = true
+	m.lock.Unlock()
+
 	go func(stopCh <-chan struct{}) {
+		active := metrics.ActiveMonitors.WithLabelValues(m.GetType())
+		active.Inc()
+		defer active.Dec()
+		defer m.setStopped()
+
 		// Set initial interval to immediately run once on startup
 		interval := time.Nanosecond
 
@@ -154,10 +498,19 @@ func (m *Monitor) Start(manager CloudManager) {
 					continue
 				}
 
+				if paused, _, err := IsPaused(m.Manager.GetKubernetesClient(), m.Object); err == nil && paused {
+					// Leave the resource alone until it is resumed.
+					m.V(2).Info("paused", "key", m.GetKey())
+					interval = m.Interval
+					continue
+				}
+
 				stop, err := m.Run(client)
 
 				m.V(1).Info(m.State())
 
+				m.record(err)
+
 				if stop {
 					m.V(2).Info("completed", "key", m.GetKey())
 					if m.notify() == nil {
@@ -172,6 +525,19 @@ func (m *Monitor) Start(manager CloudManager) {
 					}
 				}
 
+				if m.checkDeadline() {
+					m.escalate()
+					if m.RetryOnDeadline {
+						m.V(2).Info("requeuing after deadline", "key", m.GetKey())
+						if m.notify() == nil {
+							m.V(2).Info("exiting on deadline", "key", m.GetKey())
+							return
+						}
+					}
+				}
+
+				m.publishStatus(m.buildStatus())
+
 				// Use the configured value on the next iteration.
 				interval = m.Interval
 			}
@@ -191,6 +557,10 @@ func (m *Monitor) Stop() {
 // notify is a utility function that updates a monitored object to force
 // a reconciliation event that triggers the reconciler.
 func (m *Monitor) notify() error {
+	// Clear the monitor status before triggering the reconciler so that the
+	// status update does not race with the reconciler.
+	m.publishStatus(nil)
+
 	err := m.Manager.NotifyResource(m.Object)
 	if err != nil {
 		err = errors.Cause(err)
@@ -227,21 +597,104 @@ func (m *Monitor) handleClientError(err error) (stop bool) {
 // DefaultNewStrategyRequiredMonitorInterval represents the default interval between
 // polling attempts to check
 const DefaultNewStrategyRequiredMonitorInterval = 15 * time.Second
-const DefaultMaxStrategyRetryCount = 120
+
+// StrategyRetriesExhausted is the outcome recorded in the strategy history
+// when a strategy is abandoned because its requests kept failing.
+const StrategyRetriesExhausted = "retries-exhausted"
+
+// StrategyRetryBackoff returns the delay before the next attempt of a request
+// which failed the given number of consecutive times.  The delay starts at
+// the monitor interval and doubles after each failure up to the given limit.
+func StrategyRetryBackoff(count int, limit time.Duration) time.Duration {
+	if count <= 0 {
+		return 0
+	}
+
+	delay := DefaultNewStrategyRequiredMonitorInterval
+	for i := 1; i < count && delay < limit; i++ {
+		delay *= 2
+	}
+
+	if delay > limit {
+		delay = limit
+	}
+
+	return delay
+}
+
+// strategyRetryPending determines whether a failed request is still waiting
+// for its backoff delay to expire.
+func strategyRetryPending(management CloudManager, namespace string) bool {
+	next := management.GetStrategyNextRetry(namespace)
+	if !next.IsZero() && time.Now().Before(next) {
+		log.V(2).Info("Waiting before retrying strategy request", "next", next)
+		return true
+	}
+
+	return false
+}
+
+// strategyRequestFailed records a failed request and schedules the next
+// attempt.  It returns the number of consecutive failures and whether the
+// maximum number of retries has been exceeded.
+func strategyRequestFailed(management CloudManager, namespace string) (int, bool) {
+	options := management.GetStrategyOptions(namespace)
+
+	c, err := management.GetStrategyRetryCount(namespace)
+	if err != nil {
+		log.Error(err, "Fail to obtain strategy retry count")
+	}
+	log.V(2).Info("Obtain current retry count", "retry count", c)
+	c++
+
+	if c > *options.MaxRetries {
+		return c, true
+	}
+
+	err = management.SetStrategyRetryCount(namespace, c)
+	if err != nil {
+		log.Error(err, "Fail to update strategy retry count", "count", c)
+	}
+
+	delay := StrategyRetryBackoff(c, time.Duration(*options.MaxRetryInterval)*time.Second)
+	management.SetStrategyNextRetry(namespace, time.Now().Add(delay))
+	log.Info("Strategy request will be retried", "count", c, "delay", delay)
+
+	return c, false
+}
+
+// strategyRequestSucceeded clears the failures of previous requests.
+func strategyRequestSucceeded(management CloudManager, namespace string) {
+	management.SetStrategyNextRetry(namespace, time.Time{})
+	err := management.SetStrategyRetryCount(namespace, 0)
+	if err != nil {
+		log.Error(err, "Fail to clear strategy retry count")
+	}
+}
+
+// recordStrategyOutcome adds the outcome of a strategy to the strategy
+// history of the System resource.
+func recordStrategyOutcome(management CloudManager, namespace string, id string, outcome string, reason string) {
+	entry := v1.StrategyHistoryEntry{ID: id, Outcome: outcome, FailureReason: reason}
+	err := management.RecordStrategyHistory(namespace, entry)
+	if err != nil {
+		log.Error(err, "Fail to record strategy history", "outcome", outcome)
+	}
+}
 
 // StrategyRequiredMonitor is a monitor to analyze the strategy needs
-func StrategyRequiredMonitor(management CloudManager) {
-	log.Info("StrategyRequiredMonitor starts")
+func StrategyRequiredMonitor(management CloudManager, namespace string) {
+	log.Info("StrategyRequiredMonitor starts", "namespace", namespace)
 	for {
 		time.Sleep(DefaultNewStrategyRequiredMonitorInterval)
-		finished := ManageStrategy(management)
+		finished := ManageStrategy(management, namespace)
 		if finished {
 			//Clear strategy
-			management.ClearStrategy()
+			management.ClearStrategy(namespace)
 			break
 		}
 	}
-	log.Info("StrategyRequiredMonitor ends")
+	log.Info("StrategyRequiredMonitor ends", "namespace", namespace)
 }
 
 func deleteStrategy(management CloudManager, c *gophercloud.ServiceClient) {
@@ -251,8 +704,264 @@ func deleteStrategy(management CloudManager, c *gophercloud.ServiceClient) {
 	log.Info("Strategy deleted", "result", r)
 }
 
-func monitorStrategyState(management CloudManager) bool {
-	client := management.GetVimClient()
+// Strategy phase names reported in the System status.
+const (
+	StrategyPhaseBuild = "build"
+	StrategyPhaseApply = "apply"
+	StrategyPhaseAbort = "abort"
+)
+
+// IsStrategyFailed determines whether a strategy state is a failure state.
+func IsStrategyFailed(state string) bool {
+	switch state {
+	case StrategyBuildFailed, StrategyBuildTimeout, StrategyApplyFailed, StrategyApplyTimeout,
+		StrategyAbortFailed, StrategyAbortTimeout, StrategyAborted:
+		return true
+	}
+	return false
+}
+
+// strategyPhase returns the name and details of the strategy phase to which
+// the current strategy state belongs.
+func strategyPhase(s *systemconfigupdate.SystemConfigUpdate) (string, *systemconfigupdate.SystemConfigPhase) {
+	switch s.State {
+	case StrategyApplying, StrategyApplyFailed, StrategyApplyTimeout, StrategyApplied:
+		return StrategyPhaseApply, &s.ApplyPhase
+	case StrategyAborting, StrategyAbortFailed, StrategyAbortTimeout, StrategyAborted:
+		return StrategyPhaseAbort, &s.AbortPhase
+	default:
+		return StrategyPhaseBuild, &s.BuildPhase
+	}
+}
+
+// NewStrategyProgress builds the System strategy status from the strategy
+// reported by the VIM.  The previous status is used to retain the start time,
+// triggers, and last failure reason of the same strategy across updates.
+func NewStrategyProgress(previous *v1.SystemStrategyStatus, s *systemconfigupdate.SystemConfigUpdate, pendingApproval bool, now metav1.Time) *v1.SystemStrategyStatus {
+	name, phase := strategyPhase(s)
+
+	progress := v1.SystemStrategyStatus{
+		ID:                   s.ID,
+		State:                s.State,
+		Phase:                name,
+		PendingApproval:      pendingApproval,
+		CurrentStage:         phase.CurrentStage,
+		TotalStages:          phase.TotalStages,
+		CompletionPercentage: phase.CompletionPercentage,
+	}
+
+	var stageReason string
+	for _, stage := range phase.Stages {
+		if stage.StageId == phase.CurrentStage {
+			progress.StageName = stage.StageName
+			stageReason = stage.Reason
+			break
+		}
+	}
+
+	if previous != nil && previous.ID == s.ID {
+		progress.StartTime = previous.StartTime
+		progress.FinishTime = previous.FinishTime
+		progress.FailureReason = previous.FailureReason
+		progress.Triggers = previous.Triggers
+	}
+
+	if progress.StartTime == nil {
+		progress.StartTime = now.DeepCopy()
+	}
+
+	if IsStrategyFailed(s.State) {
+		switch {
+		case phase.Reason != "":
+			progress.FailureReason = phase.Reason
+		case stageReason != "":
+			progress.FailureReason = stageReason
+		default:
+			progress.FailureReason = s.State
+		}
+	}
+
+	if (IsStrategyFailed(s.State) || s.State == StrategyApplied) && progress.FinishTime == nil {
+		progress.FinishTime = now.DeepCopy()
+	}
+
+	return &progress
+}
+
+// updateStrategyProgress records the progress of the strategy in the status
+// of the System resource.
+func updateStrategyProgress(management CloudManager, namespace string, s *systemconfigupdate.SystemConfigUpdate, pendingApproval bool) {
+	err := management.UpdateStrategyProgress(namespace, s, pendingApproval)
+	if err != nil {
+		log.Error(err, "Fail to update strategy progress", "state", s.State)
+	}
+}
+
+// Strategy actions sent to the VIM.
+const (
+	StrategyActionApplyAll   = "apply-all"
+	StrategyActionApplyStage = "apply-stage"
+	StrategyActionAbort      = "abort"
+)
+
+// nextStrategyStage returns the first stage of the apply phase which has not
+// completed successfully.
+func nextStrategyStage(s *systemconfigupdate.SystemConfigUpdate) int {
+	for _, stage := range s.ApplyPhase.Stages {
+		if stage.Result != "success" {
+			return stage.StageId
+		}
+	}
+
+	return len(s.ApplyPhase.Stages)
+}
+
+// nextStrategyAction determines the action to be sent to progress the
+// strategy according to the approval mode.  If the strategy, or its next
+// stage, cannot progress until it is approved then no action is returned and
+// pending is set.
+func nextStrategyAction(mode string, approval StrategyApproval, s *systemconfigupdate.SystemConfigUpdate) (action *systemconfigupdate.StrategyActionOpts, pending bool) {
+	switch s.State {
+	case StrategyReadyToApply:
+	case StrategyApplying:
+		// Only a strategy applied stage by stage is expected to stop between
+		// stages while in the applying state.
+		if mode != v1.StrategyApprovalStage || s.ApplyPhase.Inprogress {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	switch mode {
+	case v1.StrategyApprovalManual:
+		if s.State != StrategyReadyToApply {
+			return nil, false
+		} else if approval.ApprovedID != s.ID {
+			return nil, true
+		}
+
+	case v1.StrategyApprovalStage:
+		stage := nextStrategyStage(s)
+		if len(s.ApplyPhase.Stages) > 0 && stage >= len(s.ApplyPhase.Stages) {
+			return nil, false
+		} else if !approval.IsApproved(s.ID, stage) {
+			return nil, true
+		}
+
+		a := StrategyActionApplyStage
+		id := strconv.Itoa(stage)
+		return &systemconfigupdate.StrategyActionOpts{Action: &a, StageID: &id}, false
+
+	default:
+		if s.State != StrategyReadyToApply {
+			return nil, false
+		}
+	}
+
+	a := StrategyActionApplyAll
+	return &systemconfigupdate.StrategyActionOpts{Action: &a}, false
+}
+
+// getStrategyApproval returns the approvals given for the strategy.  Any
+// error is logged and treated as if no approval was given.
+func getStrategyApproval(management CloudManager, namespace string) StrategyApproval {
+	approval, err := management.GetStrategyApproval(namespace)
+	if err != nil {
+		log.Error(err, "Fail to obtain strategy approval")
+		return StrategyApproval{}
+	}
+
+	return approval
+}
+
+// sendStrategyAction sends an apply action and tracks the number of
+// attempts.  It returns true if the strategy was deleted because the maximum
+// number of attempts was exceeded.
+func sendStrategyAction(management CloudManager, namespace string, client *gophercloud.ServiceClient, id string, action systemconfigupdate.StrategyActionOpts) bool {
+	if strategyRetryPending(management, namespace) {
+		return false
+	}
+
+	log.Info("Sending stragety action", "StrategyActionOpts", action)
+	_, err := management.GcActionStrategy(client, action)
+	if err != nil {
+		log.Error(err, "Strategy apply failed.")
+		c, exhausted := strategyRequestFailed(management, namespace)
+		if exhausted {
+			log.Error(err, "Retry exceeds to apply strategy")
+			reason := fmt.Sprintf("strategy apply action failed %d times: %s", c-1, err.Error())
+			recordStrategyOutcome(management, namespace, id, StrategyRetriesExhausted, reason)
+			deleteStrategy(management, client)
+			return true
+		}
+
+	} else {
+		strategyRequestSucceeded(management, namespace)
+
+		// Update strategy applied in System
+		if namespace == "" {
+			log.Info("System namespace does not exist. Skip")
+		} else {
+			err = management.SetStrategyAppliedSent(namespace, true)
+			if err != nil {
+				log.Error(err, "Set strategy applied sent true error")
+			}
+		}
+	}
+
+	return false
+}
+
+// abortStrategy handles a request to abort the strategy.  A strategy which
+// is being built or applied is aborted through the VIM so that the aborting
+// and aborted states can be tracked, whereas a strategy which has not been
+// applied yet is simply deleted.  It returns true if the strategy was
+// deleted.
+func abortStrategy(management CloudManager, namespace string, client *gophercloud.ServiceClient, s *systemconfigupdate.SystemConfigUpdate) bool {
+	if s.State == StrategyReadyToApply {
+		log.Info("Strategy abort requested before being applied")
+		aborted := *s
+		aborted.State = StrategyAborted
+		aborted.AbortPhase.Reason = "aborted before being applied"
+		updateStrategyProgress(management, namespace, &aborted, false)
+		recordStrategyOutcome(management, namespace, s.ID, StrategyAborted, "")
+		deleteStrategy(management, client)
+		return true
+	}
+
+	a := StrategyActionAbort
+	action := systemconfigupdate.StrategyActionOpts{Action: &a}
+	log.Info("Sending stragety action", "StrategyActionOpts", action)
+	_, err := management.GcActionStrategy(client, action)
+	if err != nil {
+		log.Error(err, "Strategy abort failed.")
+	}
+
+	return false
+}
+
+// waitForMaintenanceWindow determines whether a disruptive strategy action
+// must wait for the next maintenance window configured on the System.  The
+// System status reflects the pending action until the window opens.
+func waitForMaintenanceWindow(management CloudManager, namespace string, pending string) bool {
+	open, next := MaintenanceWindowOpen(management.GetMaintenanceWindows(namespace), time.Now())
+	if open {
+		pending = ""
+	} else {
+		log.Info("Waiting for maintenance window", "action", pending, "next", next)
+	}
+
+	err := management.UpdateMaintenancePending(namespace, pending, next)
+	if err != nil {
+		log.Error(err, "Fail to update maintenance status")
+	}
+
+	return !open
+}
+
+func monitorStrategyState(management CloudManager, namespace string) bool {
+	client := management.GetVimClient(namespace)
 	if client == nil {
 		log.Info("Vim client is not ready. Wait")
 		return false
@@ -267,80 +976,84 @@ func monitorStrategyState(management CloudManager) bool {
 	log.Info("Strategy status", "state", s.State)
 	log.V(2).Info("Strategy status", "show", s)
 
+	options := management.GetStrategyOptions(namespace)
+	approval := getStrategyApproval(management, namespace)
+	action, pending := nextStrategyAction(*options.ApprovalMode, approval, s)
+
+	updateStrategyProgress(management, namespace, s, pending)
+
+	if approval.IsAbortRequested(s.ID) {
+		switch s.State {
+		case StrategyBuilding, StrategyReadyToApply, StrategyApplying:
+			return abortStrategy(management, namespace, client, s)
+		}
+	}
+
 	switch s.State {
 	case StrategyReadyToApply:
-		// Apply strategy
-		a := "apply-all"
-		action := systemconfigupdate.StrategyActionOpts{
-			Action: &a,
+		if action == nil {
+			log.Info("Strategy waiting for approval", "mode", *options.ApprovalMode)
+			return false
 		}
-		log.Info("Sending stragety action", "StrategyActionOpts", action)
-		_, err = management.GcActionStrategy(client, action)
-		if err != nil {
-			log.Error(err, "Strategy apply failed.")
-			c, err := management.GetStrategyRetryCount()
-			if err != nil {
-				log.Error(err, "Fail to obtain strategy retry count")
-			}
-			c++
-			// Check max retry count
-			if c > DefaultMaxStrategyRetryCount {
-				log.Error(err, "Retry exceeds to apply strategy")
-				deleteStrategy(management, client)
-				return true
-			}
-			// Update retry count
-			err = management.SetStrategyRetryCount(c)
-			if err != nil {
-				log.Error(err, "Fail to update strategy retry count", "count", c)
-			}
 
-		} else {
-			// Update strategy applied in System
-			namespace := management.GetNamespace()
-			if namespace == "" {
-				log.Info("System namespace does not exist. Skip")
-			} else {
-				err = management.SetStrategyAppliedSent(namespace, true)
-				if err != nil {
-					log.Error(err, "Set strategy applied sent true error")
-				}
-			}
+		if waitForMaintenanceWindow(management, namespace, "strategy apply") {
+			return false
 		}
+
+		return sendStrategyAction(management, namespace, client, s.ID, *action)
+
 	case StrategyBuildFailed:
 		log.Error(err, "Strategy build failed", "reason", s.BuildPhase.Reason)
+		recordStrategyOutcome(management, namespace, s.ID, s.State, "")
 		deleteStrategy(management, client)
 		return true
 
 	case StrategyApplyFailed:
 		log.Error(err, "Strategy apply failed", "reason", s.ApplyPhase.Reason)
+		recordStrategyOutcome(management, namespace, s.ID, s.State, "")
 		deleteStrategy(management, client)
 		return true
 
 	case StrategyApplying:
 		log.Info("Strategy applying", "percentage", s.ApplyPhase.CompletionPercentage, "stage", s.ApplyPhase.CurrentStage)
+		if action != nil {
+			if waitForMaintenanceWindow(management, namespace, "strategy apply") {
+				return false
+			}
+			return sendStrategyAction(management, namespace, client, s.ID, *action)
+		} else if pending {
+			log.Info("Strategy stage waiting for approval", "stage", nextStrategyStage(s))
+		}
+
+	case StrategyAborting:
+		log.Info("Strategy aborting", "percentage", s.AbortPhase.CompletionPercentage, "stage", s.AbortPhase.CurrentStage)
+
+	case StrategyAborted:
+		log.Info("Strategy aborted", "reason", s.AbortPhase.Reason)
+		recordStrategyOutcome(management, namespace, s.ID, s.State, "")
+		deleteStrategy(management, client)
+		return true
 
-	case StrategyBuildTimeout, StrategyApplyTimeout, StrategyAbortFailed, StrategyAbortTimeout, StrategyAborted:
+	case StrategyBuildTimeout, StrategyApplyTimeout, StrategyAbortFailed, StrategyAbortTimeout:
 		log.Error(err, "Error occuured in strategy", "state", s.State)
+		recordStrategyOutcome(management, namespace, s.ID, s.State, "")
 		deleteStrategy(management, client)
 		return true
 
 	case StrategyApplied:
 		log.Info("Strategy applied. Finish strategy monitor.")
+		recordStrategyOutcome(management, namespace, s.ID, s.State, "")
 		deleteStrategy(management, client)
 		return true
 	}
 	return false
 }
 
-func MonitorExistingStrategy(strategy_status *systemconfigupdate.SystemConfigUpdate, management CloudManager) {
+func MonitorExistingStrategy(strategy_status *systemconfigupdate.SystemConfigUpdate, management CloudManager, namespace string) {
 	log.V(2).Info(fmt.Sprintf(
 		"Found existing strategy which is %s state, it will be monitored.", strategy_status.State))
-	management.StrategySent()
-	err := management.SetStrategyRetryCount(0)
-	if err != nil {
-		log.Error(err, "Fail to clear strategy retry count")
-	}
+	management.StrategySent(namespace)
+	strategyRequestSucceeded(management, namespace)
 }
 
 // This function returns true if a strategy exists and is already in applied state.
@@ -380,41 +1093,113 @@ func IsExistingStrategy(management CloudManager, client *gophercloud.ServiceClie
 	return false, nil, nil
 }
 
+// Default strategy options used for any option which is not configured on
+// the System resource.
+const (
+	DefaultStrategyAlarmRestrictions     = v1.StrategyAlarmRestrictionsStrict
+	DefaultStrategyWorkerApplyType       = v1.StrategyApplyTypeParallel
+	DefaultStrategyStorageApplyType      = v1.StrategyApplyTypeSerial
+	DefaultStrategyMaxParallelWorkers    = 10
+	DefaultStrategyDefaultInstanceAction = v1.StrategyInstanceActionStopStart
+	DefaultStrategyApprovalMode          = v1.StrategyApprovalAuto
+	DefaultStrategyMaxRetries            = 10
+	DefaultStrategyMaxRetryInterval      = 600
+)
+
+// ResolveStrategyOptions returns a fully populated set of strategy options
+// where any option not set in the supplied options is set to its default
+// value.
+func ResolveStrategyOptions(options *v1.StrategyInfo) v1.StrategyInfo {
+	alarmRestrictions := DefaultStrategyAlarmRestrictions
+	workerApplyType := DefaultStrategyWorkerApplyType
+	storageApplyType := DefaultStrategyStorageApplyType
+	maxParallelWorkers := DefaultStrategyMaxParallelWorkers
+	defaultInstanceAction := DefaultStrategyDefaultInstanceAction
+	approvalMode := DefaultStrategyApprovalMode
+	maxRetries := DefaultStrategyMaxRetries
+	maxRetryInterval := DefaultStrategyMaxRetryInterval
+
+	if options != nil {
+		if options.AlarmRestrictions != nil {
+			alarmRestrictions = *options.AlarmRestrictions
+		}
+		if options.WorkerApplyType != nil {
+			workerApplyType = *options.WorkerApplyType
+		}
+		if options.StorageApplyType != nil {
+			storageApplyType = *options.StorageApplyType
+		}
+		if options.MaxParallelWorkers != nil {
+			maxParallelWorkers = *options.MaxParallelWorkers
+		}
+		if options.DefaultInstanceAction != nil {
+			defaultInstanceAction = *options.DefaultInstanceAction
+		}
+		if options.ApprovalMode != nil {
+			approvalMode = *options.ApprovalMode
+		}
+		if options.MaxRetries != nil {
+			maxRetries = *options.MaxRetries
+		}
+		if options.MaxRetryInterval != nil {
+			maxRetryInterval = *options.MaxRetryInterval
+		}
+	}
+
+	return v1.StrategyInfo{
+		AlarmRestrictions:     &alarmRestrictions,
+		WorkerApplyType:       &workerApplyType,
+		StorageApplyType:      &storageApplyType,
+		MaxParallelWorkers:    &maxParallelWorkers,
+		DefaultInstanceAction: &defaultInstanceAction,
+		ApprovalMode:          &approvalMode,
+		MaxRetries:            &maxRetries,
+		MaxRetryInterval:      &maxRetryInterval,
+	}
+}
+
 // Run function for StrategyRequiredMonitor
 // responsible for monitor resource information and send
 // strategy if needed
-func ManageStrategy(management CloudManager) bool {
+func ManageStrategy(management CloudManager, namespace string) bool {
 
 	log.V(2).Info("ManageStrategy Run start")
 
+	if paused, err := IsNamespacePaused(management.GetKubernetesClient(), namespace); err == nil && paused {
+		// Neither create nor advance a strategy while the system is paused.
+		log.V(2).Info("ManageStrategy paused", "namespace", namespace)
+		return false
+	}
+
 	// Check version
 	// If monitor version is not equal to config version,
 	// wait until configuration is updated
-	config_version := management.GetConfigVersion()
-	monitor_version := management.GetMonitorVersion()
+	config_version := management.GetConfigVersion(namespace)
+	monitor_version := management.GetMonitorVersion(namespace)
 	if monitor_version != config_version {
-		management.SetMonitorVersion(config_version)
+		management.SetMonitorVersion(namespace, config_version)
 		log.V(2).Info("ManageStrategy monitor version different. Wait until matched")
 		return false
 	}
 
-	resource := management.GetStrategyRequiredList()
+	resource := management.GetStrategyRequiredList(namespace)
 
 	// Monitor strategy status after strategy is sent
-	if management.GetStrategySent() {
-		r := monitorStrategyState(management)
+	if management.GetStrategySent(namespace) {
+		r := monitorStrategyState(management, namespace)
 		return r
 	}
 
-	monitor_list := management.ListStrategyRequired()
+	monitor_list := management.ListStrategyRequired(namespace)
 	log.V(2).Info("Current Strategy Required List", "StrategyStatus", monitor_list)
 
 	// If strategy is not sent yet, check necessity
+	options := management.GetStrategyOptions(namespace)
 	var request systemconfigupdate.SystemConfigUpdateOpts
-	request.AlarmRestrictions = "strict"
+	request.AlarmRestrictions = *options.AlarmRestrictions
 	request.ControllerApplyType = "ignore"
-	request.DefaultInstanceAction = "stop-start"
-	request.MaxParallerWorkers = 10
+	request.DefaultInstanceAction = *options.DefaultInstanceAction
+	request.MaxParallerWorkers = *options.MaxParallelWorkers
 	request.StorageApplyType = "ignore"
 	request.WorkerApplyType = "ignore"
 	request_needed := false
@@ -429,7 +1214,7 @@ func ManageStrategy(management CloudManager) bool {
 		case ResourceSystem:
 			if r.StrategyRequired != StrategyNotRequired {
 				request.ControllerApplyType = "serial"
-				request.WorkerApplyType = "parallel"
+				request.WorkerApplyType = *options.WorkerApplyType
 				request_needed = true
 			}
 		case ResourceHost:
@@ -438,19 +1223,19 @@ func ManageStrategy(management CloudManager) bool {
 				if r.StrategyRequired != StrategyNotRequired {
 					log.V(2).Info("Strategy required in controller")
 					request.ControllerApplyType = "serial"
-					request.WorkerApplyType = "parallel"
+					request.WorkerApplyType = *options.WorkerApplyType
 					request_needed = true
 				}
 			case PersonalityWorker:
 				if r.StrategyRequired != StrategyNotRequired {
 					log.V(2).Info("Strategy required in worker")
-					request.WorkerApplyType = "parallel"
+					request.WorkerApplyType = *options.WorkerApplyType
 					request_needed = true
 				}
 			case PersonalityStorage:
 				if r.StrategyRequired != StrategyNotRequired {
 					log.V(2).Info("Strategy required in storage")
-					request.StorageApplyType = "serial"
+					request.StorageApplyType = *options.StorageApplyType
 					request_needed = true
 				}
 			case PersonalityControllerWorker:
@@ -459,7 +1244,7 @@ func ManageStrategy(management CloudManager) bool {
 		}
 	}
 	if request_needed {
-		client := management.GetVimClient()
+		client := management.GetVimClient(namespace)
 		if client == nil {
 			log.Info("Vim client is not ready. Wait")
 			return false
@@ -477,35 +1262,36 @@ func ManageStrategy(management CloudManager) bool {
 				}
 
 			} else if is_existing_strategy {
-				MonitorExistingStrategy(strategy_status, management)
-				management.SetStrategyExpectedByOtherReconcilers(false)
+				MonitorExistingStrategy(strategy_status, management, namespace)
+				management.SetStrategyExpectedByOtherReconcilers(namespace, false)
+				return false
+			}
+
+			if waitForMaintenanceWindow(management, namespace, "strategy creation") {
+				return false
+			}
+
+			if strategyRetryPending(management, namespace) {
 				return false
 			}
 
 			log.Info("Sending stragety request", "SystemConfigUpdateOpts", request)
-			_, err = management.GcCreate(client, request)
-			management.SetStrategyExpectedByOtherReconcilers(false)
+			created, err := management.GcCreate(client, request)
+			management.SetStrategyExpectedByOtherReconcilers(namespace, false)
 			if err != nil {
 				log.Error(err, "Strategy creation failed")
-				c, err := management.GetStrategyRetryCount()
-				if err != nil {
-					log.Error(err, "Fail to obtain strategy retry count")
-				}
-				log.V(2).Info("Obtain current retry count", "retry count", c)
-				c++
-				err = management.SetStrategyRetryCount(c)
-				if err != nil {
-					log.Error(err, "Fail to update strategy retry count", "count", c)
-				}
-				if c > DefaultMaxStrategyRetryCount {
+				c, exhausted := strategyRequestFailed(management, namespace)
+				if exhausted {
 					log.Error(err, "Retry exceeds to create strategy")
+					reason := fmt.Sprintf("strategy creation failed %d times: %s", c-1, err.Error())
+					recordStrategyOutcome(management, namespace, "", StrategyRetriesExhausted, reason)
 					return true
 				}
 			} else {
-				management.StrategySent()
-				err = management.SetStrategyRetryCount(0)
-				if err != nil {
-					log.Error(err, "Fail to clear strategy retry count")
+				management.StrategySent(namespace)
+				strategyRequestSucceeded(management, namespace)
+				if created != nil {
+					updateStrategyProgress(management, namespace, created, false)
 				}
 				log.Info("Stragety request sent")
 			}
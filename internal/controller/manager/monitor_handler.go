@@ -0,0 +1,56 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// MonitorDebugPath is the path of the monitor debug endpoint.  It is served
+// by the metrics server and is therefore subject to the same authentication
+// and authorization as the metrics endpoint.
+const MonitorDebugPath = "/debug/monitors"
+
+// MonitorList is the response returned by the monitor debug endpoint.
+type MonitorList struct {
+	Monitors []MonitorDescription `json:"monitors"`
+}
+
+// monitorHandler serves the list of active monitors.
+type monitorHandler struct {
+	manager CloudManager
+}
+
+// NewMonitorHandler returns an HTTP handler which reports the active monitors
+// as a JSON document.  The optional "namespace" query parameter restricts the
+// list to the monitors of a single namespace.
+func NewMonitorHandler(manager CloudManager) http.Handler {
+	return &monitorHandler{manager: manager}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *monitorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+
+	result := MonitorList{Monitors: make([]MonitorDescription, 0)}
+	for _, monitor := range h.manager.ListMonitors() {
+		if namespace == "" || monitor.Namespace == namespace {
+			result.Monitors = append(result.Monitors, monitor)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Error(err, "failed to encode monitor list")
+	}
+}
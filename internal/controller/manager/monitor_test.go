@@ -4,16 +4,47 @@
 package manager
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/starlingx/nfv/v1/systemconfigupdate"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+// testMonitorBody is a monitor body which reports a fixed state.
+type testMonitorBody struct {
+	CommonMonitorBody
+}
+
+func (b *testMonitorBody) Run(client *gophercloud.ServiceClient) (stop bool, err error) {
+	b.SetState("waiting for host to become unlocked/enabled")
+	return false, nil
+}
+
+// testNamespace is the namespace of the system whose strategy is managed.
+const testNamespace = "deployment"
+
 var _ = Describe("Monitor", func() {
 	Describe("Check return for monitorStrategyState", func() {
 		Context("when failing to obtain vim client", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{vimClientAvailable: false, gcShow: ""}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyDeleted).To(BeFalse())
 			})
@@ -21,7 +52,7 @@ var _ = Describe("Monitor", func() {
 		Context("when failing to obtain strategy status", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: ""}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyDeleted).To(BeFalse())
 			})
@@ -29,7 +60,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy ready to apply", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyActionSend).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeFalse())
@@ -37,8 +68,8 @@ var _ = Describe("Monitor", func() {
 		})
 		Context("when strategy apply error occurs before retry exceeds", func() {
 			It("should return false and strategy action not sent", func() {
-				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true, retryCount: 10}
-				got := monitorStrategyState(dm)
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true, retryCount: DefaultStrategyMaxRetries - 1}
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyActionSend).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeFalse())
@@ -46,8 +77,8 @@ var _ = Describe("Monitor", func() {
 		})
 		Context("when strategy apply error occurs and retry exceeds", func() {
 			It("should return true, strategy action not sent and strategy deleted", func() {
-				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true, retryCount: DefaultMaxStrategyRetryCount + 1}
-				got := monitorStrategyState(dm)
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true, retryCount: DefaultStrategyMaxRetries}
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyActionSend).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
@@ -56,7 +87,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is build failed", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyBuildFailed}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -64,7 +95,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy apply failed", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplyFailed}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -72,7 +103,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy applying", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplying}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyDeleted).To(BeFalse())
 			})
@@ -80,7 +111,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy build timeout", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyBuildTimeout}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -88,7 +119,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy apply timeout", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplyTimeout}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -96,7 +127,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy abort failed", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyAbortFailed}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -104,7 +135,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy aborting", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyAborting}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyDeleted).To(BeFalse())
 			})
@@ -112,7 +143,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy abort timeout", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyAbortTimeout}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -120,7 +151,7 @@ var _ = Describe("Monitor", func() {
 		Context("when status is strategy applied", func() {
 			It("should return true and strategy deleted", func() {
 				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplied}
-				got := monitorStrategyState(dm)
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyDeleted).To(BeTrue())
 			})
@@ -131,7 +162,7 @@ var _ = Describe("Monitor", func() {
 		Context("when monitor version does not match config version", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{config_version: 0, monitor_version: 1}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 			})
 		})
@@ -144,7 +175,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeFalse())
 			})
@@ -158,7 +189,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyCreated).To(BeFalse())
 			})
@@ -173,7 +204,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeTrue())
 				Expect(dm.strategySent).To(BeTrue())
@@ -193,7 +224,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeTrue())
 				Expect(dm.strategySent).To(BeTrue())
@@ -213,7 +244,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeTrue())
 				Expect(dm.strategySent).To(BeTrue())
@@ -233,7 +264,7 @@ var _ = Describe("Monitor", func() {
 					},
 				}
 				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeTrue())
 				Expect(dm.strategySent).To(BeTrue())
@@ -252,8 +283,8 @@ var _ = Describe("Monitor", func() {
 						Reconciled:       true,
 					},
 				}
-				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, strategyCreateError: true, retryCount: 10}
-				got := ManageStrategy(dm)
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, strategyCreateError: true, retryCount: DefaultStrategyMaxRetries - 1}
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 				Expect(dm.strategyCreated).To(BeFalse())
 			})
@@ -268,24 +299,416 @@ var _ = Describe("Monitor", func() {
 						Reconciled:       true,
 					},
 				}
-				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, strategyCreateError: true, retryCount: DefaultMaxStrategyRetryCount + 1}
-				got := ManageStrategy(dm)
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, strategyCreateError: true, retryCount: DefaultStrategyMaxRetries}
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeTrue())
 				Expect(dm.strategyCreated).To(BeFalse())
 			})
 		})
+		Context("when lock is required outside of the maintenance window", func() {
+			It("should return false and strategy not created", func() {
+				rsc := map[string]*ResourceInfo{
+					"controller-0": {
+						ResourceType:     ResourceSystem,
+						StrategyRequired: StrategyLockRequired,
+						Reconciled:       true,
+					},
+				}
+				start := time.Now().UTC().Add(2 * time.Hour).Format("15:04")
+				maintenance := &starlingxv1.MaintenanceInfo{
+					Windows: []starlingxv1.MaintenanceWindowInfo{{Start: start, Duration: "1h"}},
+				}
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, maintenance: maintenance}
+				got := ManageStrategy(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyCreated).To(BeFalse())
+				Expect(dm.maintenancePending).ToNot(BeNil())
+				Expect(dm.maintenancePending.Pending).To(Equal("strategy creation"))
+				Expect(dm.maintenancePending.NextWindow).ToNot(BeNil())
+			})
+		})
+		Context("when lock is required inside the maintenance window", func() {
+			It("should return false and strategy created and sent", func() {
+				rsc := map[string]*ResourceInfo{
+					"controller-0": {
+						ResourceType:     ResourceSystem,
+						StrategyRequired: StrategyLockRequired,
+						Reconciled:       true,
+					},
+				}
+				start := time.Now().UTC().Add(-30 * time.Minute).Format("15:04")
+				maintenance := &starlingxv1.MaintenanceInfo{
+					Windows: []starlingxv1.MaintenanceWindowInfo{{Start: start, Duration: "2h"}},
+				}
+				dm := &Dummymanager{strategySent: false, Resource: rsc, vimClientAvailable: true, maintenance: maintenance,
+					maintenancePending: &starlingxv1.MaintenanceWindowStatus{Pending: "strategy creation"}}
+				got := ManageStrategy(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyCreated).To(BeTrue())
+				Expect(dm.maintenancePending).To(BeNil())
+			})
+		})
+		Context("when strategy is ready to apply outside of the maintenance window", func() {
+			It("should return false and strategy action not sent", func() {
+				start := time.Now().UTC().Add(2 * time.Hour).Format("15:04")
+				maintenance := &starlingxv1.MaintenanceInfo{
+					Windows: []starlingxv1.MaintenanceWindowInfo{{Start: start, Duration: "1h"}},
+				}
+				dm := &Dummymanager{strategySent: true, vimClientAvailable: true, gcShow: StrategyReadyToApply, maintenance: maintenance}
+				got := ManageStrategy(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyActionSend).To(BeFalse())
+				Expect(dm.maintenancePending.Pending).To(Equal("strategy apply"))
+			})
+		})
 		Context("when strategy is applying after strategy sent", func() {
 			It("should return false", func() {
 				dm := &Dummymanager{strategySent: true, vimClientAvailable: true, gcShow: StrategyApplying}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
 				Expect(got).To(BeFalse())
 			})
 		})
 		Context("when strategy is applied after strategy sent", func() {
 			It("should return true", func() {
 				dm := &Dummymanager{strategySent: true, vimClientAvailable: true, gcShow: StrategyApplied}
-				got := ManageStrategy(dm)
+				got := ManageStrategy(dm, testNamespace)
+				Expect(got).To(BeTrue())
+			})
+		})
+	})
+	Describe("Check ResolveStrategyOptions", func() {
+		Context("when no options are configured", func() {
+			It("should return the default options", func() {
+				got := ResolveStrategyOptions(nil)
+				Expect(*got.AlarmRestrictions).To(Equal(starlingxv1.StrategyAlarmRestrictionsStrict))
+				Expect(*got.WorkerApplyType).To(Equal(starlingxv1.StrategyApplyTypeParallel))
+				Expect(*got.StorageApplyType).To(Equal(starlingxv1.StrategyApplyTypeSerial))
+				Expect(*got.MaxParallelWorkers).To(Equal(10))
+				Expect(*got.DefaultInstanceAction).To(Equal(starlingxv1.StrategyInstanceActionStopStart))
+				Expect(*got.MaxRetries).To(Equal(DefaultStrategyMaxRetries))
+				Expect(*got.MaxRetryInterval).To(Equal(DefaultStrategyMaxRetryInterval))
+			})
+		})
+		Context("when some options are configured", func() {
+			It("should only default the options which are not configured", func() {
+				alarms := starlingxv1.StrategyAlarmRestrictionsRelaxed
+				workers := 50
+				got := ResolveStrategyOptions(&starlingxv1.StrategyInfo{
+					AlarmRestrictions:  &alarms,
+					MaxParallelWorkers: &workers,
+				})
+				Expect(*got.AlarmRestrictions).To(Equal(starlingxv1.StrategyAlarmRestrictionsRelaxed))
+				Expect(*got.MaxParallelWorkers).To(Equal(50))
+				Expect(*got.WorkerApplyType).To(Equal(starlingxv1.StrategyApplyTypeParallel))
+			})
+		})
+	})
+	Describe("Check StrategyRetryBackoff", func() {
+		Context("with consecutive failures", func() {
+			It("should double the delay up to the limit", func() {
+				limit := 100 * time.Second
+				Expect(StrategyRetryBackoff(0, limit)).To(Equal(time.Duration(0)))
+				Expect(StrategyRetryBackoff(1, limit)).To(Equal(15 * time.Second))
+				Expect(StrategyRetryBackoff(2, limit)).To(Equal(30 * time.Second))
+				Expect(StrategyRetryBackoff(3, limit)).To(Equal(60 * time.Second))
+				Expect(StrategyRetryBackoff(4, limit)).To(Equal(limit))
+				Expect(StrategyRetryBackoff(1000, limit)).To(Equal(limit))
+			})
+		})
+	})
+	Describe("Check strategy retries and history", func() {
+		Context("when a failed request is waiting for its backoff delay", func() {
+			It("should not send the strategy action", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, nextRetry: time.Now().Add(time.Minute)}
+				got := monitorStrategyState(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyActionSend).To(BeFalse())
+			})
+		})
+		Context("when the strategy action fails", func() {
+			It("should schedule the next retry", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true}
+				got := monitorStrategyState(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.nextRetry.After(time.Now())).To(BeTrue())
+				Expect(dm.strategyHistory).To(BeEmpty())
+			})
+		})
+		Context("when the strategy action retries are exhausted", func() {
+			It("should record the outcome in the history", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyActionError: true, retryCount: DefaultStrategyMaxRetries}
+				got := monitorStrategyState(dm, testNamespace)
 				Expect(got).To(BeTrue())
+				Expect(dm.strategyHistory).To(HaveLen(1))
+				Expect(dm.strategyHistory[0].ID).To(Equal("abc-def"))
+				Expect(dm.strategyHistory[0].Outcome).To(Equal(StrategyRetriesExhausted))
+				Expect(dm.strategyHistory[0].FailureReason).To(ContainSubstring("action sent error"))
+			})
+		})
+		Context("when the strategy is applied", func() {
+			It("should record the outcome in the history", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplied}
+				got := monitorStrategyState(dm, testNamespace)
+				Expect(got).To(BeTrue())
+				Expect(dm.strategyHistory).To(HaveLen(1))
+				Expect(dm.strategyHistory[0].Outcome).To(Equal(StrategyApplied))
+				Expect(dm.strategyHistory[0].Duration).ToNot(BeEmpty())
+			})
+		})
+	})
+	Describe("Check NewStrategyProgress", func() {
+		Context("when the strategy is applying", func() {
+			It("should report the apply phase progress", func() {
+				now := metav1.Now()
+				s := &systemconfigupdate.SystemConfigUpdate{
+					ID:    "abc-def",
+					State: StrategyApplying,
+					ApplyPhase: systemconfigupdate.SystemConfigPhase{
+						CompletionPercentage: 40,
+						CurrentStage:         1,
+						TotalStages:          3,
+						Stages: []systemconfigupdate.SystemConfigStage{
+							{StageId: 0, StageName: "system-config-update-controllers"},
+							{StageId: 1, StageName: "system-config-update-worker-hosts"},
+						},
+					},
+				}
+				got := NewStrategyProgress(nil, s, false, now)
+				Expect(got.ID).To(Equal("abc-def"))
+				Expect(got.Phase).To(Equal(StrategyPhaseApply))
+				Expect(got.CompletionPercentage).To(Equal(40))
+				Expect(got.CurrentStage).To(Equal(1))
+				Expect(got.TotalStages).To(Equal(3))
+				Expect(got.StageName).To(Equal("system-config-update-worker-hosts"))
+				Expect(got.StartTime).To(Equal(&now))
+				Expect(got.FinishTime).To(BeNil())
+				Expect(got.FailureReason).To(BeEmpty())
+			})
+		})
+		Context("when the strategy failed", func() {
+			It("should record the failure reason and keep the start time", func() {
+				start := metav1.NewTime(metav1.Now().Add(-time.Hour))
+				previous := &starlingxv1.SystemStrategyStatus{
+					ID: "abc-def", State: StrategyApplying, StartTime: &start,
+				}
+				s := &systemconfigupdate.SystemConfigUpdate{
+					ID:         "abc-def",
+					State:      StrategyApplyFailed,
+					ApplyPhase: systemconfigupdate.SystemConfigPhase{Reason: "alarms present"},
+				}
+				now := metav1.Now()
+				got := NewStrategyProgress(previous, s, false, now)
+				Expect(got.FailureReason).To(Equal("alarms present"))
+				Expect(got.StartTime).To(Equal(&start))
+				Expect(got.FinishTime).To(Equal(&now))
+			})
+		})
+		Context("when a new strategy replaces a previous one", func() {
+			It("should not retain the details of the previous strategy", func() {
+				start := metav1.NewTime(metav1.Now().Add(-time.Hour))
+				previous := &starlingxv1.SystemStrategyStatus{
+					ID: "abc-def", State: StrategyBuildTimeout, FailureReason: StrategyBuildTimeout,
+					StartTime: &start, FinishTime: &start,
+				}
+				s := &systemconfigupdate.SystemConfigUpdate{ID: "ghi-jkl", State: StrategyBuilding}
+				now := metav1.Now()
+				got := NewStrategyProgress(previous, s, false, now)
+				Expect(got.Phase).To(Equal(StrategyPhaseBuild))
+				Expect(got.FailureReason).To(BeEmpty())
+				Expect(got.StartTime).To(Equal(&now))
+				Expect(got.FinishTime).To(BeNil())
+			})
+		})
+	})
+	Describe("Check strategy approval", func() {
+		Context("when the approval mode is manual", func() {
+			It("should wait for the strategy to be approved", func() {
+				mode := starlingxv1.StrategyApprovalManual
+				options := &starlingxv1.StrategyInfo{ApprovalMode: &mode}
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyOptions: options}
+				got := monitorStrategyState(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyActionSend).To(BeFalse())
+				Expect(dm.strategyProgress.PendingApproval).To(BeTrue())
+
+				dm.strategyApproval = StrategyApproval{ApprovedID: "abc-def"}
+				got = monitorStrategyState(dm, testNamespace)
+				Expect(got).To(BeFalse())
+				Expect(dm.strategyAction).To(Equal(StrategyActionApplyAll))
+				Expect(dm.strategyProgress.PendingApproval).To(BeFalse())
+			})
+			It("should ignore an approval given for a different strategy", func() {
+				mode := starlingxv1.StrategyApprovalManual
+				options := &starlingxv1.StrategyInfo{ApprovalMode: &mode}
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply, strategyOptions: options,
+					strategyApproval: StrategyApproval{ApprovedID: "old-id"}}
+				Expect(monitorStrategyState(dm, testNamespace)).To(BeFalse())
+				Expect(dm.strategyActionSend).To(BeFalse())
+			})
+		})
+		Context("when the approval mode is stage", func() {
+			s := &systemconfigupdate.SystemConfigUpdate{
+				ID:    "abc-def",
+				State: StrategyApplying,
+				ApplyPhase: systemconfigupdate.SystemConfigPhase{
+					Stages: []systemconfigupdate.SystemConfigStage{
+						{StageId: 0, Result: "success"},
+						{StageId: 1, Result: "initial"},
+						{StageId: 2, Result: "initial"},
+					},
+				},
+			}
+			It("should only apply approved stages", func() {
+				stage := 0
+				approval := StrategyApproval{ApprovedID: "abc-def", ApprovedStage: &stage}
+				action, pending := nextStrategyAction(starlingxv1.StrategyApprovalStage, approval, s)
+				Expect(action).To(BeNil())
+				Expect(pending).To(BeTrue())
+
+				stage = 1
+				action, pending = nextStrategyAction(starlingxv1.StrategyApprovalStage, approval, s)
+				Expect(pending).To(BeFalse())
+				Expect(*action.Action).To(Equal(StrategyActionApplyStage))
+				Expect(*action.StageID).To(Equal("1"))
+			})
+			It("should not send an action while a stage is in progress", func() {
+				inprogress := *s
+				inprogress.ApplyPhase.Inprogress = true
+				action, pending := nextStrategyAction(starlingxv1.StrategyApprovalStage, StrategyApproval{ApprovedID: "abc-def"}, &inprogress)
+				Expect(action).To(BeNil())
+				Expect(pending).To(BeFalse())
+			})
+		})
+		Context("when an abort is requested", func() {
+			It("should send the abort action if the strategy is applying", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyApplying,
+					strategyApproval: StrategyApproval{AbortID: "abc-def"}}
+				Expect(monitorStrategyState(dm, testNamespace)).To(BeFalse())
+				Expect(dm.strategyAction).To(Equal(StrategyActionAbort))
+				Expect(dm.strategyDeleted).To(BeFalse())
+			})
+			It("should delete the strategy if it has not been applied", func() {
+				dm := &Dummymanager{vimClientAvailable: true, gcShow: StrategyReadyToApply,
+					strategyApproval: StrategyApproval{AbortID: "abc-def"}}
+				Expect(monitorStrategyState(dm, testNamespace)).To(BeTrue())
+				Expect(dm.strategyActionSend).To(BeFalse())
+				Expect(dm.strategyDeleted).To(BeTrue())
+				Expect(dm.strategyProgress.State).To(Equal(StrategyAborted))
+			})
+		})
+	})
+	Describe("Check monitor introspection", func() {
+		var host *starlingxv1.Host
+		var k8sClient client.Client
+		var monitor *Monitor
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(starlingxv1.AddToScheme(scheme)).To(Succeed())
+
+			host = &starlingxv1.Host{
+				ObjectMeta: metav1.ObjectMeta{Name: "controller-0", Namespace: testNamespace, UID: "1234"},
+			}
+			k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(host).WithStatusSubresource(host).Build()
+
+			monitor = &Monitor{
+				MonitorBody: &testMonitorBody{},
+				Logger:      logr.Discard(),
+				Manager:     &Dummymanager{Client: k8sClient},
+				Interval:    30 * time.Second,
+				Object:      host,
+				message:     "waiting for host",
+				startTime:   time.Now().Add(-12 * time.Minute),
+				running:     true,
+			}
+		})
+		Context("when the monitor has not run yet", func() {
+			It("should report the reconciler message", func() {
+				got := monitor.Describe()
+				Expect(got.Type).To(Equal("testMonitorBody"))
+				Expect(got.Kind).To(Equal("Host"))
+				Expect(got.Name).To(Equal("controller-0"))
+				Expect(got.Namespace).To(Equal(testNamespace))
+				Expect(got.Interval).To(Equal("30s"))
+				Expect(got.LastRun).To(BeNil())
+				Expect(got.Summary).To(Equal("waiting for host since 12m"))
+			})
+		})
+		Context("when the monitor has run", func() {
+			It("should report the monitor state and last error", func() {
+				_, _ = monitor.Run(nil)
+				monitor.record(errors.New("connection refused"))
+
+				got := monitor.Describe()
+				Expect(got.State).To(Equal("waiting for host to become unlocked/enabled"))
+				Expect(got.LastError).To(Equal("connection refused"))
+				Expect(got.LastRun).ToNot(BeNil())
+			})
+			It("should mirror the monitor state into the status and clear it", func() {
+				_, _ = monitor.Run(nil)
+				monitor.record(nil)
+				monitor.publishStatus(monitor.buildStatus())
+
+				updated := &starlingxv1.Host{}
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(host), updated)).To(Succeed())
+				Expect(updated.Status.Monitor).ToNot(BeNil())
+				Expect(updated.Status.Monitor.Type).To(Equal("testMonitorBody"))
+				Expect(updated.Status.Monitor.Message).To(Equal("waiting for host to become unlocked/enabled"))
+				Expect(updated.Status.Monitor.Interval).To(Equal("30s"))
+
+				monitor.publishStatus(nil)
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(host), updated)).To(Succeed())
+				Expect(updated.Status.Monitor).To(BeNil())
+			})
+		})
+		Context("when the deadline has passed", func() {
+			It("should report the resource as degraded once", func() {
+				recorder := record.NewFakeRecorder(10)
+				monitor.Manager = &Dummymanager{Client: k8sClient, Recorder: recorder}
+				monitor.Deadline = 10 * time.Minute
+
+				Expect(monitor.checkDeadline()).To(BeTrue())
+				monitor.escalate()
+				Expect(monitor.checkDeadline()).To(BeFalse())
+
+				Expect(recorder.Events).To(Receive(ContainSubstring(MonitorDeadlineExceededReason)))
+
+				updated := &starlingxv1.Host{}
+				Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(host), updated)).To(Succeed())
+				c := meta.FindStatusCondition(updated.Status.Conditions, starlingxv1.ConditionDegraded)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionTrue))
+				Expect(c.Reason).To(Equal(starlingxv1.ReasonMonitorDeadlineExceeded))
+				Expect(c.Message).To(Equal("testMonitorBody did not complete within 10m0s: waiting for host"))
+
+				status := monitor.buildStatus()
+				Expect(status.Deadline).To(Equal("10m0s"))
+				Expect(status.DeadlineExceeded).To(BeTrue())
+			})
+			It("should not expire before the deadline", func() {
+				monitor.Deadline = time.Hour
+				Expect(monitor.checkDeadline()).To(BeFalse())
+			})
+		})
+		Context("when the debug endpoint is queried", func() {
+			It("should list the running monitors of the requested namespace", func() {
+				stopped := &Monitor{MonitorBody: &testMonitorBody{}, Logger: logr.Discard(), Object: &starlingxv1.System{}}
+				pm := &PlatformManager{monitors: map[string]*Monitor{"1234": monitor, "5678": stopped}}
+
+				recorder := httptest.NewRecorder()
+				request := httptest.NewRequest(http.MethodGet, MonitorDebugPath+"?namespace="+testNamespace, nil)
+				NewMonitorHandler(pm).ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				result := MonitorList{}
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &result)).To(Succeed())
+				Expect(result.Monitors).To(HaveLen(1))
+				Expect(result.Monitors[0].Key).To(Equal("1234"))
+
+				recorder = httptest.NewRecorder()
+				request = httptest.NewRequest(http.MethodGet, MonitorDebugPath+"?namespace=other", nil)
+				NewMonitorHandler(pm).ServeHTTP(recorder, request)
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &result)).To(Succeed())
+				Expect(result.Monitors).To(BeEmpty())
 			})
 		})
 	})
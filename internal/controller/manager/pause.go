@@ -0,0 +1,63 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package manager
+
+import (
+	"context"
+	"strconv"
+
+	perrors "github.com/pkg/errors"
+	v1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// isPauseAnnotated determines whether the pause annotation is set on an
+// object.
+func isPauseAnnotated(object client.Object) bool {
+	paused, err := strconv.ParseBool(object.GetAnnotations()[PauseKey])
+	return err == nil && paused
+}
+
+// IsNamespacePaused determines whether the System resource of a namespace is
+// paused, in which case no resource of the namespace must be acted upon.
+func IsNamespacePaused(c client.Reader, namespace string) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+
+	systems := &v1.SystemList{}
+	err := c.List(context.TODO(), systems, client.InNamespace(namespace))
+	if err != nil {
+		err = perrors.Wrapf(err, "failed to list systems in namespace %s", namespace)
+		return false, err
+	}
+
+	for i := range systems.Items {
+		if isPauseAnnotated(&systems.Items[i]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// IsPaused determines whether a resource is paused either through the
+// PauseKey annotation on the resource itself or on the System resource of its
+// namespace.  The returned reason identifies which of the two applies.
+func IsPaused(c client.Reader, object client.Object) (bool, string, error) {
+	if isPauseAnnotated(object) {
+		return true, v1.ReasonPauseRequested, nil
+	}
+
+	if _, ok := object.(*v1.System); ok {
+		return false, "", nil
+	}
+
+	paused, err := IsNamespacePaused(c, object.GetNamespace())
+	if err != nil || !paused {
+		return false, "", err
+	}
+
+	return true, v1.ReasonSystemPaused, nil
+}
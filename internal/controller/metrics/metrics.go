@@ -0,0 +1,170 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Namespace is the prefix applied to every metric published by the
+// deployment manager.
+const Namespace = "deployment_manager"
+
+// Defines the values used for the result label of the reconcile metrics.
+const (
+	ResultSuccess = "success"
+	ResultRequeue = "requeue"
+	ResultError   = "error"
+)
+
+var (
+	// ReconcileTotal counts the number of reconcile attempts per resource
+	// kind and result.
+	ReconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "reconcile_total",
+			Help:      "Total number of reconcile attempts per resource kind and result.",
+		}, []string{"kind", "result"})
+
+	// ReconcileErrors counts the number of reconcile errors per resource kind
+	// and error class.
+	ReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of reconcile errors per resource kind and error class.",
+		}, []string{"kind", "class"})
+
+	// ReconcileDuration tracks the time spent reconciling each resource kind.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Time spent reconciling resources per resource kind and result.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		}, []string{"kind", "result"})
+
+	// APIRequestDuration tracks the latency of the requests sent to the
+	// platform APIs.
+	APIRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "api_request_duration_seconds",
+			Help:      "Latency of platform API requests per endpoint, method and resource path.",
+			Buckets:   []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"endpoint", "method", "path"})
+
+	// APIRequestErrors counts the platform API requests that failed either
+	// at the transport level or with an error status code.
+	APIRequestErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "api_request_errors_total",
+			Help:      "Total number of failed platform API requests per endpoint, method, resource path and code.",
+		}, []string{"endpoint", "method", "path", "code"})
+
+	// ActiveMonitors tracks the number of monitors currently running per
+	// monitor type.
+	ActiveMonitors = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "monitors_active",
+			Help:      "Number of resource monitors currently running per monitor type.",
+		}, []string{"type"})
+
+	// MonitorDeadlinesExceeded counts the monitors which were still running
+	// after their deadline per monitor type.
+	MonitorDeadlinesExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "monitor_deadlines_exceeded_total",
+			Help:      "Total number of resource monitors which exceeded their deadline per monitor type.",
+		}, []string{"type"})
+
+	// DriftDetected counts the number of times that the configuration of the
+	// system was found to have drifted from the desired configuration of a
+	// reconciled resource per resource kind.
+	DriftDetected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "drift_detected_total",
+			Help:      "Total number of configuration drifts detected on reconciled resources per resource kind.",
+		}, []string{"kind"})
+
+	// StrategyState is set to 1 for the current state of the strategy of each
+	// system namespace.
+	StrategyState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "strategy_state",
+			Help:      "Current state of the VIM strategy per system namespace; the active state is set to 1.",
+		}, []string{"namespace", "state"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		ReconcileTotal,
+		ReconcileErrors,
+		ReconcileDuration,
+		APIRequestDuration,
+		APIRequestErrors,
+		ActiveMonitors,
+		MonitorDeadlinesExceeded,
+		DriftDetected,
+		StrategyState,
+	)
+}
+
+// SetStrategyState records the current strategy state of a namespace.  An
+// empty state removes the namespace from the gauge.
+func SetStrategyState(namespace string, state string) {
+	StrategyState.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+	if state != "" {
+		StrategyState.WithLabelValues(namespace, state).Set(1)
+	}
+}
+
+// ReconcileResult determines the result label value from the values returned
+// by a reconciler.
+func ReconcileResult(result reconcile.Result, err error) string {
+	if err != nil {
+		return ResultError
+	} else if result.Requeue || result.RequeueAfter > 0 {
+		return ResultRequeue
+	}
+
+	return ResultSuccess
+}
+
+// instrumentedReconciler wraps a reconciler so that the outcome and duration
+// of each reconcile attempt is recorded.
+type instrumentedReconciler struct {
+	reconcile.Reconciler
+	kind string
+}
+
+// Reconcile runs the wrapped reconciler and records its outcome.
+func (r *instrumentedReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	start := time.Now()
+
+	result, err := r.Reconciler.Reconcile(ctx, request)
+
+	label := ReconcileResult(result, err)
+	ReconcileTotal.WithLabelValues(r.kind, label).Inc()
+	ReconcileDuration.WithLabelValues(r.kind, label).Observe(time.Since(start).Seconds())
+
+	return result, err
+}
+
+// NewReconciler returns a reconciler which records the reconcile metrics of
+// the specified resource kind before handing off to the actual reconciler.
+func NewReconciler(kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	return &instrumentedReconciler{Reconciler: r, kind: kind}
+}
@@ -0,0 +1,107 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type fakeReconciler struct {
+	result reconcile.Result
+	err    error
+}
+
+func (r *fakeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	return r.result, r.err
+}
+
+var _ = Describe("Metrics", func() {
+	Describe("NormalizePath", func() {
+		It("replaces UUID and integer segments", func() {
+			Expect(NormalizePath("/v1/ihosts/0e7c3a3e-5b4f-4d1c-9f0a-2c7a9d0c1b2e/ports")).To(
+				Equal("/v1/ihosts/{id}/ports"))
+			Expect(NormalizePath("/v1/ihosts/3/idisks")).To(Equal("/v1/ihosts/{id}/idisks"))
+		})
+		It("keeps resource names", func() {
+			Expect(NormalizePath("/api/orchestration/system-config-update/strategy")).To(
+				Equal("/api/orchestration/system-config-update/strategy"))
+			Expect(NormalizePath("/v1/isystems")).To(Equal("/v1/isystems"))
+		})
+	})
+
+	Describe("ReconcileResult", func() {
+		It("classifies the reconciler return values", func() {
+			Expect(ReconcileResult(reconcile.Result{}, nil)).To(Equal(ResultSuccess))
+			Expect(ReconcileResult(reconcile.Result{RequeueAfter: time.Minute}, nil)).To(Equal(ResultRequeue))
+			Expect(ReconcileResult(reconcile.Result{Requeue: true}, nil)).To(Equal(ResultRequeue))
+			Expect(ReconcileResult(reconcile.Result{}, errors.New("failed"))).To(Equal(ResultError))
+		})
+	})
+
+	Describe("NewReconciler", func() {
+		It("records the outcome of each reconcile attempt", func() {
+			r := NewReconciler("TestKind", &fakeReconciler{result: reconcile.Result{RequeueAfter: time.Second}})
+			before := testutil.ToFloat64(ReconcileTotal.WithLabelValues("TestKind", ResultRequeue))
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Second))
+			Expect(testutil.ToFloat64(ReconcileTotal.WithLabelValues("TestKind", ResultRequeue))).To(Equal(before + 1))
+		})
+	})
+
+	Describe("APIRoundTripper", func() {
+		It("records request errors per resource path", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewAPIRoundTripper("sysinv", nil)}
+			counter := APIRequestErrors.WithLabelValues("sysinv", http.MethodGet, "/v1/ihosts/{id}", "404")
+			before := testutil.ToFloat64(counter)
+
+			response, err := client.Get(server.URL + "/v1/ihosts/42")
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+			Expect(testutil.ToFloat64(counter)).To(Equal(before + 1))
+		})
+	})
+
+	Describe("SetStrategyState", func() {
+		It("only keeps the latest state of a namespace", func() {
+			SetStrategyState("test", "building")
+			SetStrategyState("test", "applying")
+			Expect(testutil.ToFloat64(StrategyState.WithLabelValues("test", "applying"))).To(Equal(1.0))
+			SetStrategyState("test", "")
+			Expect(testutil.CollectAndCount(StrategyState)).To(Equal(0))
+		})
+	})
+
+	Describe("countResources", func() {
+		It("aggregates resource states per namespace", func() {
+			counts := countResources([]ResourceState{
+				{Namespace: "a", InSync: true, Reconciled: true},
+				{Namespace: "a", InSync: false, Reconciled: true, Drifted: true, StrategyRequired: "lock_required"},
+				{Namespace: "b", InSync: false, Reconciled: false, StrategyRequired: "lock_required"},
+			})
+			Expect(counts).To(HaveLen(2))
+			Expect(counts["a"].total).To(Equal(2))
+			Expect(counts["a"].inSync).To(Equal(1))
+			Expect(counts["a"].reconciled).To(Equal(2))
+			Expect(counts["a"].drifted).To(Equal(1))
+			Expect(counts["a"].strategy).To(Equal(map[string]int{"lock_required": 1}))
+			Expect(counts["b"].inSync).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,302 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var log = logf.Log.WithName("metrics")
+
+// DefaultResourceListTimeout is the maximum amount of time that a scrape will
+// wait for the resource lists.
+const DefaultResourceListTimeout = 10 * time.Second
+
+var (
+	resourcesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "resources"),
+		"Number of resources per resource kind and namespace.",
+		[]string{"kind", "namespace"}, nil)
+
+	resourcesInSyncDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "resources_in_sync"),
+		"Number of resources whose desired state matches the operational state per resource kind and namespace.",
+		[]string{"kind", "namespace"}, nil)
+
+	resourcesReconciledDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "resources_reconciled"),
+		"Number of resources that have been successfully reconciled at least once per resource kind and namespace.",
+		[]string{"kind", "namespace"}, nil)
+
+	resourcesDriftedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "resources_drifted"),
+		"Number of resources whose system configuration drifted from the desired state after being reconciled per resource kind and namespace.",
+		[]string{"kind", "namespace"}, nil)
+
+	resourcesStrategyRequiredDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "resources_strategy_required"),
+		"Number of resources per resource kind, namespace and required strategy type.",
+		[]string{"kind", "namespace", "strategy"}, nil)
+)
+
+// ResourceState is the subset of the status of a resource which is published
+// as metrics.
+type ResourceState struct {
+	Namespace        string
+	InSync           bool
+	Reconciled       bool
+	Drifted          bool
+	StrategyRequired string
+}
+
+// resourceLister lists the state of all resources of a given kind.
+type resourceLister func(ctx context.Context, reader client.Reader) ([]ResourceState, error)
+
+// resourceCounts holds the aggregated resource counts of a single kind and
+// namespace.
+type resourceCounts struct {
+	total      int
+	inSync     int
+	reconciled int
+	drifted    int
+	strategy   map[string]int
+}
+
+// ResourceCollector is a prometheus collector which publishes the sync state
+// of the resources managed by the deployment manager.  The values are
+// computed from the cached resource lists at scrape time so that they never
+// go stale when resources are deleted.
+type ResourceCollector struct {
+	reader  client.Reader
+	listers map[string]resourceLister
+}
+
+// NewResourceCollector returns a collector which reads resources from the
+// specified client.
+func NewResourceCollector(reader client.Reader) *ResourceCollector {
+	return &ResourceCollector{
+		reader: reader,
+		listers: map[string]resourceLister{
+			"AddressPool":     listAddressPools,
+			"DataNetwork":     listDataNetworks,
+			"Host":            listHosts,
+			"PlatformNetwork": listPlatformNetworks,
+			"PtpInstance":     listPtpInstances,
+			"PtpInterface":    listPtpInterfaces,
+			"System":          listSystems,
+		},
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *ResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resourcesDesc
+	ch <- resourcesInSyncDesc
+	ch <- resourcesReconciledDesc
+	ch <- resourcesDriftedDesc
+	ch <- resourcesStrategyRequiredDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *ResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultResourceListTimeout)
+	defer cancel()
+
+	for kind, lister := range c.listers {
+		states, err := lister(ctx, c.reader)
+		if err != nil {
+			log.Error(err, "failed to list resources", "kind", kind)
+			continue
+		}
+
+		for namespace, counts := range countResources(states) {
+			ch <- prometheus.MustNewConstMetric(resourcesDesc,
+				prometheus.GaugeValue, float64(counts.total), kind, namespace)
+			ch <- prometheus.MustNewConstMetric(resourcesInSyncDesc,
+				prometheus.GaugeValue, float64(counts.inSync), kind, namespace)
+			ch <- prometheus.MustNewConstMetric(resourcesReconciledDesc,
+				prometheus.GaugeValue, float64(counts.reconciled), kind, namespace)
+			ch <- prometheus.MustNewConstMetric(resourcesDriftedDesc,
+				prometheus.GaugeValue, float64(counts.drifted), kind, namespace)
+			for strategy, count := range counts.strategy {
+				ch <- prometheus.MustNewConstMetric(resourcesStrategyRequiredDesc,
+					prometheus.GaugeValue, float64(count), kind, namespace, strategy)
+			}
+		}
+	}
+}
+
+// countResources aggregates the resource states per namespace.  The strategy
+// counts only include resources that report a strategy requirement.
+func countResources(states []ResourceState) map[string]*resourceCounts {
+	result := make(map[string]*resourceCounts)
+
+	for _, s := range states {
+		counts, ok := result[s.Namespace]
+		if !ok {
+			counts = &resourceCounts{strategy: make(map[string]int)}
+			result[s.Namespace] = counts
+		}
+
+		counts.total++
+		if s.InSync {
+			counts.inSync++
+		}
+		if s.Reconciled {
+			counts.reconciled++
+		}
+		if s.Drifted {
+			counts.drifted++
+		}
+		if s.StrategyRequired != "" {
+			counts.strategy[s.StrategyRequired]++
+		}
+	}
+
+	return result
+}
+
+func listAddressPools(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.AddressPoolList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:  obj.Namespace,
+			InSync:     obj.Status.InSync,
+			Reconciled: obj.Status.Reconciled})
+	}
+
+	return result, nil
+}
+
+func listDataNetworks(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.DataNetworkList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:        obj.Namespace,
+			InSync:           obj.Status.InSync,
+			Reconciled:       obj.Status.Reconciled,
+			Drifted:          meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted),
+			StrategyRequired: obj.Status.StrategyRequired})
+	}
+
+	return result, nil
+}
+
+func listHosts(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.HostList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:        obj.Namespace,
+			InSync:           obj.Status.InSync,
+			Reconciled:       obj.Status.Reconciled,
+			Drifted:          meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted),
+			StrategyRequired: obj.Status.StrategyRequired})
+	}
+
+	return result, nil
+}
+
+func listPlatformNetworks(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.PlatformNetworkList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:  obj.Namespace,
+			InSync:     obj.Status.InSync,
+			Reconciled: obj.Status.Reconciled,
+			Drifted:    meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted)})
+	}
+
+	return result, nil
+}
+
+func listPtpInstances(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.PtpInstanceList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:        obj.Namespace,
+			InSync:           obj.Status.InSync,
+			Reconciled:       obj.Status.Reconciled,
+			Drifted:          meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted),
+			StrategyRequired: obj.Status.StrategyRequired})
+	}
+
+	return result, nil
+}
+
+func listPtpInterfaces(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.PtpInterfaceList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:        obj.Namespace,
+			InSync:           obj.Status.InSync,
+			Reconciled:       obj.Status.Reconciled,
+			Drifted:          meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted),
+			StrategyRequired: obj.Status.StrategyRequired})
+	}
+
+	return result, nil
+}
+
+func listSystems(ctx context.Context, reader client.Reader) ([]ResourceState, error) {
+	list := &starlingxv1.SystemList{}
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceState, 0, len(list.Items))
+	for _, obj := range list.Items {
+		result = append(result, ResourceState{
+			Namespace:        obj.Namespace,
+			InSync:           obj.Status.InSync,
+			Reconciled:       obj.Status.Reconciled,
+			Drifted:          meta.IsStatusConditionTrue(obj.Status.Conditions, starlingxv1.ConditionDrifted),
+			StrategyRequired: obj.Status.StrategyRequired})
+	}
+
+	return result, nil
+}
+
+// RegisterResourceCollector registers a resource collector reading from the
+// specified client with the controller runtime metrics registry.
+func RegisterResourceCollector(reader client.Reader) error {
+	return ctrlmetrics.Registry.Register(NewResourceCollector(reader))
+}
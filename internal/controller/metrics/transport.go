@@ -0,0 +1,71 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package metrics
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PathIDPlaceholder replaces the resource identifiers found in request paths
+// so that the path label has a bounded number of values.
+const PathIDPlaceholder = "{id}"
+
+// idRegex matches path segments that are resource identifiers rather than
+// resource names; i.e., UUID values and integer indexes.
+var idRegex = regexp.MustCompile(`^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]+)$`)
+
+// NormalizePath converts a request path to a resource path template by
+// replacing every identifier segment with a placeholder.
+func NormalizePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if idRegex.MatchString(s) {
+			segments[i] = PathIDPlaceholder
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// APIRoundTripper is an http.RoundTripper which records the latency and
+// errors of each request sent to a platform API endpoint.
+type APIRoundTripper struct {
+	// Endpoint is the name of the API endpoint; i.e., sysinv or vim.
+	Endpoint string
+
+	// Rt is the transport used to actually send the request.
+	Rt http.RoundTripper
+}
+
+// NewAPIRoundTripper returns a round tripper which instruments the specified
+// transport.  The default transport is used if none is specified.
+func NewAPIRoundTripper(endpoint string, rt http.RoundTripper) *APIRoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &APIRoundTripper{Endpoint: endpoint, Rt: rt}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *APIRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	path := NormalizePath(request.URL.Path)
+	start := time.Now()
+
+	response, err := t.Rt.RoundTrip(request)
+
+	APIRequestDuration.WithLabelValues(t.Endpoint, request.Method, path).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		APIRequestErrors.WithLabelValues(t.Endpoint, request.Method, path, "error").Inc()
+	} else if response.StatusCode >= http.StatusBadRequest {
+		APIRequestErrors.WithLabelValues(t.Endpoint, request.Method, path, strconv.Itoa(response.StatusCode)).Inc()
+	}
+
+	return response, err
+}
@@ -6,6 +6,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -14,6 +15,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -81,6 +83,14 @@ func (r *PlatformNetworkReconciler) ReconcileResource(client *gophercloud.Servic
 				return common.NewUserDataError(msg)
 			}
 
+			// In dry-run mode the notification is only planned since the
+			// active host would otherwise apply the change for real.  The
+			// observed generation is left unchanged so that it is planned
+			// again until dry-run mode is disabled.
+			if common.RecordPlannedAction(client, common.PlannedNotification(host_instance, "hosts")) {
+				return nil
+			}
+
 			err = r.NotifyResource(host_instance)
 			if err != nil {
 				msg := fmt.Sprintf("Failed to notify '%s' active host instance", host_instance.Name)
@@ -121,10 +131,21 @@ func (r *PlatformNetworkReconciler) ReconcileResource(client *gophercloud.Servic
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *PlatformNetworkReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.PlatformNetwork, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *PlatformNetworkReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.PlatformNetwork)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *PlatformNetworkReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.PlatformNetwork, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // UpdateDeploymentScope function is used to update the deployment scope for PlatformNetwork.
@@ -335,14 +356,25 @@ func (r *PlatformNetworkReconciler) Reconcile(ctx context.Context, request ctrl.
 		return common.RetrySystemNotReady, nil
 	}
 
-	if !r.IsNotifyingActiveHost() {
+	reconcileFn := func(c *gophercloud.ServiceClient) error {
+		if r.IsNotifyingActiveHost() {
+			return common.NewHostNotifyError("waiting to notify active host")
+		}
+
 		r.SetNotifyingActiveHost(true)
-		err = r.ReconcileResource(platformClient, instance, request.Namespace, scopeUpdated)
-		r.SetNotifyingActiveHost(false)
-	} else {
-		err = common.NewHostNotifyError("waiting to notify active host")
+		defer r.SetNotifyingActiveHost(false)
+
+		return r.ReconcileResource(c, instance, request.Namespace, scopeUpdated)
+	}
+
+	dryRun, err := common.NewDryRun(r.Client, r.CloudManager, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if dryRun != nil {
+		return dryRun.Run(platformClient, reconcileFn)
 	}
 
+	err = reconcileFn(platformClient)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
 	}
@@ -356,13 +388,16 @@ func (r *PlatformNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logPlatformNetwork}
+		Logger:       logPlatformNetwork,
+		Kind:         "PlatformNetwork"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(PlatformNetworkControllerName),
 		Logger:        logPlatformNetwork}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PlatformNetwork{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("PlatformNetwork",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
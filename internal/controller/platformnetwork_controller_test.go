@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -196,5 +197,43 @@ var _ = Describe("Platformnetwork controller", func() {
 				Expect(instance.Status.ObservedGeneration).To(Equal(instance.Generation))
 			})
 		})
+
+		Context("when the resource is in dry-run mode", func() {
+			It("should only plan the notification of the active host", func() {
+				activeHost := &starlingxv1.Host{
+					ObjectMeta: metav1.ObjectMeta{Name: "controller-0-pn-dry-run", Namespace: "default"},
+				}
+				Expect(k8sClient.Create(ctx, activeHost)).To(Succeed())
+
+				dm := &cloudManager.Dummymanager{ActiveHost: activeHost}
+				reconciler := newPlatformNetworkReconciler(dm)
+				instance := &starlingxv1.PlatformNetwork{
+					ObjectMeta: metav1.ObjectMeta{Name: "pn-dry-run", Namespace: "default"},
+					Spec: starlingxv1.PlatformNetworkSpec{
+						Type:                   "oam",
+						Dynamic:                false,
+						AssociatedAddressPools: []string{"oam-ipv4"},
+					},
+				}
+				Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+
+				recorder := common.NewPlanRecorder(&gophercloud.ServiceClient{
+					ProviderClient: &gophercloud.ProviderClient{},
+				})
+				err := reconciler.ReconcileResource(recorder.Client(), instance, "default", false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dm.NotifiedResources).To(BeEmpty())
+				Expect(instance.Status.ObservedGeneration).ToNot(Equal(instance.Generation))
+
+				requests, _ := recorder.Requests()
+				Expect(requests).To(Equal([]starlingxv1.PlannedRequest{
+					common.PlannedNotification(activeHost, "hosts"),
+				}))
+
+				host := &starlingxv1.Host{}
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(activeHost), host)).To(Succeed())
+				Expect(host.Annotations).ToNot(HaveKey(cloudManager.NotificationCountKey))
+			})
+		})
 	})
 })
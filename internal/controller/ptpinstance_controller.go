@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -16,6 +17,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -156,10 +158,9 @@ func (r *PtpInstanceReconciler) ReconcileNew(client *gophercloud.ServiceClient,
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
-		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+		if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 			msg := common.NoProvisioningAfterReconciled
-			r.NormalEvent(instance, common.ResourceUpdated, msg)
-			return nil, common.NewChangeAfterInSync(msg)
+			return nil, common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 		} else {
 			logPtpInstance.Info(common.ProvisioningAllowedAfterReconciled)
 		}
@@ -257,7 +258,7 @@ func (r *PtpInstanceReconciler) statusUpdateRequired(instance *starlingxv1.PtpIn
 		status.ConfigurationUpdated = false
 		status.StrategyRequired = cloudManager.StrategyNotRequired
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
-			r.SetResourceInfo(cloudManager.ResourcePtpinstance, "", instance.Name, status.Reconciled, status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourcePtpinstance, "", instance.Name, status.Reconciled, status.StrategyRequired)
 		}
 		result = true
 	}
@@ -315,10 +316,9 @@ func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
-			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 				msg := common.NoProvisioningAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			} else {
 				logPtpInstance.Info(common.ProvisioningAllowedAfterReconciled)
 			}
@@ -348,10 +348,9 @@ func (r *PtpInstanceReconciler) ReconcileUpdated(client *gophercloud.ServiceClie
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
-			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 				msg := common.NoProvisioningAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			} else {
 				logPtpInstance.Info(common.ProvisioningAllowedAfterReconciled)
 			}
@@ -432,10 +431,21 @@ func (r *PtpInstanceReconciler) ReconcileResource(client *gophercloud.ServiceCli
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *PtpInstanceReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.PTPInstance, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *PtpInstanceReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.PTPInstance)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *PtpInstanceReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.PTPInstance, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // Update ReconcileAfterInSync in instance
@@ -501,8 +511,8 @@ func (r *PtpInstanceReconciler) UpdateConfigStatus(instance *starlingxv1.PtpInst
 				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 					instance.Status.Reconciled = false
 					// Update strategy required status for strategy monitor
-					r.UpdateConfigVersion()
-					r.SetResourceInfo(cloudManager.ResourcePtpinstance, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+					r.UpdateConfigVersion(instance.Namespace)
+					r.SetResourceInfo(instance.Namespace, cloudManager.ResourcePtpinstance, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
 				}
 			}
 			instance.Status.ObservedGeneration = instance.Generation
@@ -650,6 +660,15 @@ func (r *PtpInstanceReconciler) Reconcile(ctx context.Context, request ctrl.Requ
 		return common.RetrySystemNotReady, nil
 	}
 
+	dryRun, err := common.NewDryRun(r.Client, r.CloudManager, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if dryRun != nil {
+		return dryRun.Run(platformClient, func(c *gophercloud.ServiceClient) error {
+			return r.ReconcileResource(c, instance)
+		})
+	}
+
 	err = r.ReconcileResource(platformClient, instance)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
@@ -674,13 +693,16 @@ func (r *PtpInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logPtpInstance}
+		Logger:       logPtpInstance,
+		Kind:         "PtpInstance"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(PtpInstanceControllerName),
 		Logger:        logPtpInstance}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PtpInstance{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("PtpInstance",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud"
@@ -17,6 +18,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -160,10 +162,9 @@ func (r *PtpInterfaceReconciler) ReconcileNew(client *gophercloud.ServiceClient,
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
-		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+		if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 			msg := common.NoProvisioningAfterReconciled
-			r.NormalEvent(instance, common.ResourceUpdated, msg)
-			return nil, common.NewChangeAfterInSync(msg)
+			return nil, common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 		} else {
 			logPtpInterface.Info(common.ProvisioningAllowedAfterReconciled)
 		}
@@ -272,7 +273,7 @@ func (r *PtpInterfaceReconciler) statusUpdateRequired(instance *starlingxv1.PtpI
 		status.ConfigurationUpdated = false
 		status.StrategyRequired = cloudManager.StrategyNotRequired
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
-			r.SetResourceInfo(cloudManager.ResourcePtpinterface, "", instance.Name, status.Reconciled, status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourcePtpinterface, "", instance.Name, status.Reconciled, status.StrategyRequired)
 		}
 		result = true
 	}
@@ -330,10 +331,9 @@ func (r *PtpInterfaceReconciler) ReconcileUpdated(client *gophercloud.ServiceCli
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
-			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 				msg := common.NoProvisioningAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			} else {
 				logPtpInterface.Info(common.ProvisioningAllowedAfterReconciled)
 			}
@@ -362,10 +362,9 @@ func (r *PtpInterfaceReconciler) ReconcileUpdated(client *gophercloud.ServiceCli
 		if instance.Status.Reconciled && r.StopAfterInSync() {
 			// Do not process any further changes once we have reached a
 			// synchronized state unless there is an annotation on the resource.
-			if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; !present {
+			if !common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 				msg := common.NoProvisioningAfterReconciled
-				r.NormalEvent(instance, common.ResourceUpdated, msg)
-				return common.NewChangeAfterInSync(msg)
+				return common.IgnoreChangeAfterInSync(r, r.SyncMode(), instance, msg)
 			} else {
 				logPtpInterface.Info(common.ProvisioningAllowedAfterReconciled)
 			}
@@ -446,10 +445,21 @@ func (r *PtpInterfaceReconciler) ReconcileResource(client *gophercloud.ServiceCl
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *PtpInterfaceReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.PTPInterface, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *PtpInterfaceReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.PTPInterface)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *PtpInterfaceReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.PTPInterface, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // Update ReconcileAfterInSync in instance
@@ -516,8 +526,8 @@ func (r *PtpInterfaceReconciler) UpdateConfigStatus(instance *starlingxv1.PtpInt
 				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 					instance.Status.Reconciled = false
 					// Update strategy required status for strategy monitor
-					r.UpdateConfigVersion()
-					r.SetResourceInfo(cloudManager.ResourcePtpinterface, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+					r.UpdateConfigVersion(instance.Namespace)
+					r.SetResourceInfo(instance.Namespace, cloudManager.ResourcePtpinterface, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
 				}
 			}
 			instance.Status.ObservedGeneration = instance.Generation
@@ -665,6 +675,15 @@ func (r *PtpInterfaceReconciler) Reconcile(ctx context.Context, request ctrl.Req
 		return common.RetrySystemNotReady, nil
 	}
 
+	dryRun, err := common.NewDryRun(r.Client, r.CloudManager, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if dryRun != nil {
+		return dryRun.Run(platformClient, func(c *gophercloud.ServiceClient) error {
+			return r.ReconcileResource(c, instance)
+		})
+	}
+
 	err = r.ReconcileResource(platformClient, instance)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
@@ -689,13 +708,16 @@ func (r *PtpInterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logPtpInterface}
+		Logger:       logPtpInterface,
+		Kind:         "PtpInterface"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(PtpInterfaceControllerName),
 		Logger:        logPtpInterface}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.PtpInterface{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("PtpInterface",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
@@ -36,6 +36,7 @@ import (
 	utils "github.com/wind-river/cloud-platform-deployment-manager/common"
 	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/common"
 	cloudManager "github.com/wind-river/cloud-platform-deployment-manager/internal/controller/manager"
+	"github.com/wind-river/cloud-platform-deployment-manager/internal/controller/metrics"
 	v1info "github.com/wind-river/cloud-platform-deployment-manager/platform"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -575,7 +576,7 @@ func (r *SystemReconciler) FileSystemResizeAllowed(instance *starlingxv1.System,
 	if !r.ControllerNodesAvailable(required) {
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 			instance.Status.StrategyRequired = cloudManager.StrategyUnlockRequired
-			r.SetResourceInfo(cloudManager.ResourceSystem, "", instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceSystem, "", instance.Name, instance.Status.Reconciled, instance.Status.StrategyRequired)
 			err := r.Client.Status().Update(context.TODO(), instance)
 			if err != nil {
 				err = perrors.Wrapf(err, "failed to update status: %s",
@@ -1114,6 +1115,12 @@ func (r *SystemReconciler) ReconcileRequired(instance *starlingxv1.System, spec
 		return false, err
 	}
 
+	// The strategy options and maintenance windows only control how and when
+	// configuration changes are applied and are not part of the running
+	// configuration.
+	current.Strategy = spec.Strategy
+	current.Maintenance = spec.Maintenance
+
 	// We need to remove the runtime installed certificated from the current
 	if len(spec.Certificates) > 0 && len(current.Certificates) > 0 {
 		res := FixCertsToManage(spec.Certificates, current.Certificates)
@@ -1125,17 +1132,19 @@ func (r *SystemReconciler) ReconcileRequired(instance *starlingxv1.System, spec
 	logSystem.Info("current is:", "values", current)
 
 	instance.Status.InSync = spec.DeepEqual(current)
-	common.SetInstanceDelta(instance, current, spec, common.SystemProperties, r.Status(), logSystem)
+	common.SetInstanceDelta(instance, spec, current, common.SystemProperties, r.Status(), logSystem)
 
 	if instance.Status.Reconciled && r.StopAfterInSync() {
 		// Do not process any further changes once we have reached a
 		// synchronized state unless there is an annotation on the resource.
-		if _, present := instance.Annotations[cloudManager.ReconcileAfterInSync]; present {
+		if common.ReconcileAfterInSyncAllowed(r.SyncMode(), instance.Annotations) {
 			logSystem.Info(common.ChangedAllowedAfterReconciled)
 			return true, nil
 		}
 
-		r.NormalEvent(instance, common.ResourceUpdated, common.NoChangesAfterReconciled)
+		if r.SyncMode() != utils.SyncModeDetect {
+			r.NormalEvent(instance, common.ResourceUpdated, common.NoChangesAfterReconciled)
+		}
 		return false, nil
 	}
 
@@ -1191,7 +1200,7 @@ func (r *SystemReconciler) statusUpdateRequired(instance *starlingxv1.System, in
 		status.ConfigurationUpdated = false
 		status.StrategyRequired = cloudManager.StrategyNotRequired
 		if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
-			r.SetResourceInfo(cloudManager.ResourceSystem, "", instance.Name, status.Reconciled, status.StrategyRequired)
+			r.SetResourceInfo(instance.Namespace, cloudManager.ResourceSystem, "", instance.Name, status.Reconciled, status.StrategyRequired)
 		}
 		result = true
 	}
@@ -1481,10 +1490,21 @@ func (r *SystemReconciler) ReconcileResource(client *gophercloud.ServiceClient,
 
 // StopAfterInSync determines whether the reconciler should continue processing
 // change requests after the configuration has been reconciled a first time.
+// The drift detection mode never modifies the system once reconciled either.
 func (r *SystemReconciler) StopAfterInSync() bool {
-	// If the option is not found or the option was specified in a form other
-	// than a bool then assume the safest default value possible.
-	return utils.GetReconcilerOptionBool(utils.System, utils.StopAfterInSync, true)
+	return r.SyncMode() != utils.SyncModeReconcile
+}
+
+// SyncMode returns the behaviour of the reconciler once the configuration has
+// been reconciled a first time.
+func (r *SystemReconciler) SyncMode() utils.SyncMode {
+	return utils.GetReconcilerSyncMode(utils.System)
+}
+
+// DriftInterval returns how often the configuration is checked for drift in
+// the drift detection mode.
+func (r *SystemReconciler) DriftInterval() time.Duration {
+	return utils.GetReconcilerOptionDuration(utils.System, utils.DriftInterval, utils.DefaultDriftInterval)
 }
 
 // Update ReconcileAfterInSync in instance
@@ -1551,8 +1571,8 @@ func (r *SystemReconciler) UpdateConfigStatus(instance *starlingxv1.System, ns s
 				if instance.Status.DeploymentScope == cloudManager.ScopePrincipal {
 					instance.Status.Reconciled = false
 					// Update strategy required status for strategy monitor
-					r.UpdateConfigVersion()
-					r.SetResourceInfo(cloudManager.ResourceSystem, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
+					r.UpdateConfigVersion(instance.Namespace)
+					r.SetResourceInfo(instance.Namespace, cloudManager.ResourceSystem, "", instance.Name, instance.Status.Reconciled, cloudManager.StrategyNotRequired)
 				}
 			}
 			instance.Status.ObservedGeneration = instance.Generation
@@ -1721,15 +1741,30 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		}
 	}
 
+	// Hand the strategy options and maintenance windows to the strategy
+	// monitor and host reconciler regardless of the sync state so that
+	// changes are picked up by the next disruptive action.
+	r.SetStrategyOptions(instance.Namespace, instance.Spec.Strategy)
+	r.SetMaintenanceWindows(instance.Namespace, instance.Spec.Maintenance)
+
 	// If strategy is applied, start strategy monitor
 	if instance.Status.StrategyApplied {
 		logSystem.Info("Strategy applied, start strategy monitor")
-		r.StrategySent()
-		r.StartStrategyMonitor()
+		r.StrategySent(instance.Namespace)
+		r.StartStrategyMonitor(instance.Namespace)
 	} else {
 		logSystem.V(2).Info("Strategy not applied")
 	}
 
+	dryRun, err := common.NewDryRun(r.Client, r.CloudManager, r.ReconcilerEventLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	} else if dryRun != nil {
+		return dryRun.Run(platformClient, func(c *gophercloud.ServiceClient) error {
+			return r.ReconcileResource(c, instance, request)
+		})
+	}
+
 	err = r.ReconcileResource(platformClient, instance, request)
 	if err != nil {
 		return r.HandleReconcilerError(request, err)
@@ -1755,13 +1790,16 @@ func (r *SystemReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Client = mgr.GetClient()
 	r.Scheme = mgr.GetScheme()
 	r.CloudManager = tMgr
-	r.ReconcilerErrorHandler = &common.ErrorHandler{
+	errorHandler := &common.ErrorHandler{
 		CloudManager: tMgr,
-		Logger:       logSystem}
+		Logger:       logSystem,
+		Kind:         "System"}
+	r.ReconcilerErrorHandler = errorHandler
 	r.ReconcilerEventLogger = &common.EventLogger{
 		EventRecorder: mgr.GetEventRecorderFor(SystemControllerName),
 		Logger:        logSystem}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&starlingxv1.System{}).
-		Complete(r)
+		Complete(metrics.NewReconciler("System",
+			common.NewConditionsReconciler(r.Client, errorHandler, r)))
 }
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022,2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022,2025-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/starlingx/inventory/v1/datanetworks"
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -71,7 +72,11 @@ func (v *DataNetworkCustomValidator) ValidateCreate(ctx context.Context, obj run
 		return nil, fmt.Errorf("expected a DataNetwork object but got %T", obj)
 	}
 	datanetworklog.Info("validate create", "name", dataNetwork.Name)
-	return nil, validateDataNetwork(dataNetwork)
+	warnings, err := validateDeploymentScope(dataNetwork)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validateDataNetwork(dataNetwork)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -82,7 +87,15 @@ func (v *DataNetworkCustomValidator) ValidateUpdate(ctx context.Context, oldObj,
 	}
 	datanetworklog.Info("validate update", "name", dataNetwork.Name)
 
-	return nil, validateDataNetwork(dataNetwork)
+	old, ok := oldObj.(*starlingxv1.DataNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected a DataNetwork object but got %T", oldObj)
+	}
+	warnings, err := validateDeploymentScopeUpdate(old, dataNetwork, !equality.Semantic.DeepEqual(old.Spec, dataNetwork.Spec))
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validateDataNetwork(dataNetwork)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -0,0 +1,109 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	"fmt"
+
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// scopedResource defines the interface implemented by resources which have a
+// deployment scope.
+type scopedResource interface {
+	client.Object
+	GetReconciled() bool
+	GetInsync() bool
+}
+
+// deprecatedScopeWarning is returned when the deployment scope is still
+// declared through the status attribute of the last applied configuration.
+var deprecatedScopeWarning = fmt.Sprintf(
+	"status.deploymentScope is deprecated and only honoured with \"kubectl apply\"; use the %s annotation instead",
+	starlingxv1.DeploymentScopeKey)
+
+// validateDeploymentScope validates the deployment scope declared for a new
+// resource.
+func validateDeploymentScope(obj scopedResource) (admission.Warnings, error) {
+	_, source, err := starlingxv1.GetDeclaredDeploymentScope(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if source == starlingxv1.ScopeSourceLastApplied {
+		return admission.Warnings{deprecatedScopeWarning}, nil
+	}
+
+	return nil, nil
+}
+
+// validateDeploymentScopeUpdate validates the deployment scope declared for
+// an updated resource and enforces the scope transition rules:
+//   - moving from bootstrap to principal is always allowed;
+//   - moving from principal back to bootstrap is only allowed once the
+//     resource is reconciled and in sync, and must not be combined with a
+//     spec change, otherwise the pending changes would silently be ignored.
+//
+// A warning is returned when the spec of a resource which was reconciled in
+// the bootstrap scope is changed since the change may not be applied.
+//
+// Resources being deleted are not validated so that their finalizers can
+// always be removed.  Unless the DeploymentScopeKey annotation itself is
+// changed, the declared scope is not validated by updates which leave the spec
+// unchanged, such as the annotation and finalizer updates made by the
+// controllers, so that a resource admitted before the scope was validated can
+// still be reconciled.
+func validateDeploymentScopeUpdate(oldObj, newObj scopedResource, specChanged bool) (admission.Warnings, error) {
+	if !newObj.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+
+	var warnings admission.Warnings
+	if specChanged || deploymentScopeAnnotationChanged(oldObj, newObj) {
+		var err error
+		warnings, err = validateDeploymentScope(newObj)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	previous, _, err := starlingxv1.GetDeclaredDeploymentScope(oldObj)
+	if err != nil {
+		// The previous value was never admitted by this webhook so there is
+		// no valid transition to enforce.
+		return warnings, nil
+	}
+
+	scope, _, _ := starlingxv1.GetDeclaredDeploymentScope(newObj)
+
+	if previous == starlingxv1.ScopePrincipal && scope == starlingxv1.ScopeBootstrap {
+		if specChanged {
+			return warnings, fmt.Errorf("the deployment scope cannot be changed from %s to %s along with a spec change; apply the spec change in the %s scope first",
+				starlingxv1.ScopePrincipal, starlingxv1.ScopeBootstrap, starlingxv1.ScopePrincipal)
+		}
+
+		if !oldObj.GetReconciled() || !oldObj.GetInsync() {
+			return warnings, fmt.Errorf("the deployment scope cannot be changed from %s to %s until the resource is reconciled and in sync",
+				starlingxv1.ScopePrincipal, starlingxv1.ScopeBootstrap)
+		}
+	}
+
+	if scope == starlingxv1.ScopeBootstrap && specChanged && oldObj.GetReconciled() {
+		warnings = append(warnings, fmt.Sprintf(
+			"the resource has already been reconciled in the %s scope; spec changes may not be applied unless the %s annotation is set to %s",
+			starlingxv1.ScopeBootstrap, starlingxv1.DeploymentScopeKey, starlingxv1.ScopePrincipal))
+	}
+
+	return warnings, nil
+}
+
+// deploymentScopeAnnotationChanged determines whether an update changes the
+// DeploymentScopeKey annotation of a resource.
+func deploymentScopeAnnotationChanged(oldObj, newObj scopedResource) bool {
+	oldValue, oldOk := oldObj.GetAnnotations()[starlingxv1.DeploymentScopeKey]
+	newValue, newOk := newObj.GetAnnotations()[starlingxv1.DeploymentScopeKey]
+	return oldOk != newOk || oldValue != newValue
+}
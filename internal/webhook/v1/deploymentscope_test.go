@@ -0,0 +1,117 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Deployment scope validation", func() {
+	newPtpInstance := func(scope string, reconciled, inSync bool) *starlingxv1.PtpInstance {
+		obj := &starlingxv1.PtpInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "ptp1", Namespace: "default"},
+			Spec:       starlingxv1.PtpInstanceSpec{Service: "ptp4l"},
+			Status:     starlingxv1.PtpInstanceStatus{Reconciled: reconciled, InSync: inSync},
+		}
+		if scope != "" {
+			obj.Annotations = map[string]string{starlingxv1.DeploymentScopeKey: scope}
+		}
+		return obj
+	}
+
+	Context("when a resource is created", func() {
+		It("should reject an unsupported scope", func() {
+			_, err := validateDeploymentScope(newPtpInstance("Principal", false, false))
+			Expect(err).To(HaveOccurred())
+		})
+		It("should warn about the deprecated status attribute", func() {
+			obj := newPtpInstance("", false, false)
+			obj.Annotations = map[string]string{
+				starlingxv1.LastAppliedConfigKey: `{"status":{"deploymentScope":"principal"}}`,
+			}
+			warnings, err := validateDeploymentScope(obj)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+	})
+
+	Context("when the scope of a resource is changed", func() {
+		It("should always allow moving to principal", func() {
+			old := newPtpInstance(starlingxv1.ScopeBootstrap, false, false)
+			_, err := validateDeploymentScopeUpdate(old, newPtpInstance(starlingxv1.ScopePrincipal, false, false), true)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should only allow moving back to bootstrap once reconciled and in sync", func() {
+			old := newPtpInstance(starlingxv1.ScopePrincipal, true, false)
+			_, err := validateDeploymentScopeUpdate(old, newPtpInstance(starlingxv1.ScopeBootstrap, true, false), false)
+			Expect(err).To(HaveOccurred())
+
+			old = newPtpInstance(starlingxv1.ScopePrincipal, true, true)
+			_, err = validateDeploymentScopeUpdate(old, newPtpInstance("", true, true), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should reject moving back to bootstrap along with a spec change", func() {
+			old := newPtpInstance(starlingxv1.ScopePrincipal, true, true)
+			_, err := validateDeploymentScopeUpdate(old, newPtpInstance(starlingxv1.ScopeBootstrap, true, true), true)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when a resource declares an invalid legacy scope", func() {
+		newLegacyPtpInstance := func() *starlingxv1.PtpInstance {
+			obj := newPtpInstance("", true, true)
+			obj.Annotations = map[string]string{
+				starlingxv1.LastAppliedConfigKey: `{"status":{"deploymentScope":"day2"}}`,
+			}
+			return obj
+		}
+
+		It("should allow updates which leave the spec unchanged", func() {
+			old := newLegacyPtpInstance()
+			updated := newLegacyPtpInstance()
+			updated.Finalizers = []string{"ptpinstance.finalizers.windriver.com"}
+			_, err := validateDeploymentScopeUpdate(old, updated, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should allow the resource to be deleted", func() {
+			old := newLegacyPtpInstance()
+			updated := newLegacyPtpInstance()
+			now := metav1.Now()
+			updated.DeletionTimestamp = &now
+			_, err := validateDeploymentScopeUpdate(old, updated, true)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should reject spec changes", func() {
+			_, err := validateDeploymentScopeUpdate(newLegacyPtpInstance(), newLegacyPtpInstance(), true)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should reject an unsupported scope annotation", func() {
+			updated := newLegacyPtpInstance()
+			updated.Annotations[starlingxv1.DeploymentScopeKey] = "Principal"
+			_, err := validateDeploymentScopeUpdate(newLegacyPtpInstance(), updated, false)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the spec of a reconciled bootstrap resource is changed", func() {
+		It("should warn that the change may not be applied", func() {
+			old := newPtpInstance(starlingxv1.ScopeBootstrap, true, true)
+			warnings, err := validateDeploymentScopeUpdate(old, newPtpInstance(starlingxv1.ScopeBootstrap, true, true), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+	})
+
+	Context("when calling the validators directly", func() {
+		It("should enforce the transition rules on update", func() {
+			v := &PtpInstanceCustomValidator{}
+			old := newPtpInstance(starlingxv1.ScopePrincipal, false, false)
+			_, err := v.ValidateUpdate(ctx, old, newPtpInstance(starlingxv1.ScopeBootstrap, false, false))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2022, 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2022, 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -93,6 +94,12 @@ func validateHost(r *starlingxv1.Host) error {
 			return err
 		}
 	}
+	if r.Spec.Maintenance != nil {
+		err := r.Spec.Maintenance.Validate()
+		if err != nil {
+			return err
+		}
+	}
 	hostlog.Info(HostAllowedReason)
 	return nil
 }
@@ -111,7 +118,11 @@ func (v *HostCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Ob
 		return nil, fmt.Errorf("expected a Host object but got %T", obj)
 	}
 	hostlog.Info("validate create", "name", host.Name)
-	return nil, validateHost(host)
+	warnings, err := validateDeploymentScope(host)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validateHost(host)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -121,7 +132,15 @@ func (v *HostCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj
 		return nil, fmt.Errorf("expected a Host object but got %T", newObj)
 	}
 	hostlog.Info("validate update", "name", host.Name)
-	return nil, validateHost(host)
+	old, ok := oldObj.(*starlingxv1.Host)
+	if !ok {
+		return nil, fmt.Errorf("expected a Host object but got %T", oldObj)
+	}
+	warnings, err := validateDeploymentScopeUpdate(old, host, !equality.Semantic.DeepEqual(old.Spec, host.Spec))
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validateHost(host)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
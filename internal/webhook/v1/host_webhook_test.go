@@ -206,6 +206,23 @@ var _ = Describe("HostWebhook", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
+		Context("when maintenance windows have invalid data", func() {
+			It("should reject the host", func() {
+				timezone := "Mars/Olympus_Mons"
+				r := &starlingxv1.Host{
+					Spec: starlingxv1.HostSpec{
+						Maintenance: &starlingxv1.MaintenanceInfo{
+							Timezone: &timezone,
+							Windows: []starlingxv1.MaintenanceWindowInfo{
+								{Start: "02:00", Duration: "4h"},
+							},
+						},
+					},
+				}
+				err := validateHost(r)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
 
@@ -0,0 +1,68 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+
+package v1
+
+import (
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValidateOffline runs the admission validation rules against a single
+// resource without requiring access to a cluster.  Rules which depend on the
+// state of the cluster, such as the presence of the secrets referenced by
+// System certificates, are skipped and must be checked by the caller if
+// needed.  The deployment scope is validated for every resource which has
+// one, while warnings such as the use of the deprecated deploymentScope status
+// attribute are not reported.  Resource kinds without validation rules are
+// always accepted.
+func ValidateOffline(obj runtime.Object) error {
+	if r, ok := obj.(scopedResource); ok {
+		if _, err := validateDeploymentScope(r); err != nil {
+			return err
+		}
+	}
+
+	switch r := obj.(type) {
+	case *starlingxv1.System:
+		return validateSystemOffline(r)
+	case *starlingxv1.Host:
+		return validateHost(r)
+	case *starlingxv1.HostProfile:
+		return validateHostProfile(r)
+	case *starlingxv1.AddressPool:
+		return validateAddressPool(r)
+	case *starlingxv1.DataNetwork:
+		return validateDataNetwork(r)
+	case *starlingxv1.PtpInstance:
+		return validatePtpInstance(r)
+	case *starlingxv1.PtpInterface:
+		return validatePtpInterface(r)
+	}
+
+	return nil
+}
+
+// validateSystemOffline runs the System validation rules in the same order as
+// the webhook while skipping the certificate checks since those depend on
+// secrets stored in the cluster.
+func validateSystemOffline(r *starlingxv1.System) error {
+	err := validateStorage(r)
+	if err != nil {
+		return err
+	}
+
+	err = validateStrategy(r)
+	if err != nil {
+		return err
+	}
+
+	if r.Spec.Maintenance != nil {
+		err = r.Spec.Maintenance.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+/* SPDX-License-Identifier: Apache-2.0 */
+/* Copyright(c) 2026 Wind River Systems, Inc. */
+package v1
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+)
+
+var _ = Describe("Offline validation", func() {
+	Context("when the resource violates an admission rule", func() {
+		It("should return the same error as the webhook", func() {
+			base := ""
+			r := &starlingxv1.HostProfile{
+				Spec: starlingxv1.HostProfileSpec{Base: &base},
+			}
+			err := ValidateOffline(r)
+			Expect(err).To(Equal(errors.New("profile base name must not be empty")))
+		})
+	})
+
+	Context("when the system references certificates", func() {
+		It("should not require access to the cluster", func() {
+			r := &starlingxv1.System{
+				Spec: starlingxv1.SystemSpec{
+					Certificates: starlingxv1.CertificateList{
+						{Type: starlingxv1.PlatformCACertificate, Secret: "missing"},
+					},
+				},
+			}
+			Expect(ValidateOffline(r)).To(Succeed())
+		})
+	})
+
+	Context("when the system strategy is invalid", func() {
+		It("should return the same error as the webhook", func() {
+			retries := starlingxv1.StrategyMaxRetries + 1
+			r := &starlingxv1.System{
+				Spec: starlingxv1.SystemSpec{
+					Strategy: &starlingxv1.StrategyInfo{MaxRetries: &retries},
+				},
+			}
+			Expect(ValidateOffline(r)).To(MatchError(ContainSubstring("strategy max retries")))
+		})
+	})
+
+	Context("when the resource declares an unsupported deployment scope", func() {
+		It("should reject the resource", func() {
+			r := &starlingxv1.PlatformNetwork{}
+			r.Annotations = map[string]string{starlingxv1.DeploymentScopeKey: "day2"}
+			Expect(ValidateOffline(r)).ToNot(Succeed())
+		})
+	})
+
+	Context("when the resource kind has no admission rules", func() {
+		It("should accept the resource", func() {
+			Expect(ValidateOffline(&starlingxv1.PlatformNetwork{})).To(Succeed())
+		})
+	})
+})
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -62,7 +63,7 @@ func (v *PlatformNetworkCustomValidator) ValidateCreate(ctx context.Context, obj
 	hostlog.Info("validate create", "name", platformNetwork.Name)
 
 	platformnetworklog.Info("validate create", "name", platformNetwork.Name)
-	return nil, nil
+	return validateDeploymentScope(platformNetwork)
 }
 
 // TODO(sriram-gn): Identify and update validations for update of PlatformNetwork resources.
@@ -73,7 +74,11 @@ func (v *PlatformNetworkCustomValidator) ValidateUpdate(ctx context.Context, old
 		return nil, fmt.Errorf("expected a PlatformNetwork object but got %T", newObj)
 	}
 	platformnetworklog.Info("validate update", "name", platformNetwork.Name)
-	return nil, nil
+	old, ok := oldObj.(*starlingxv1.PlatformNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected a PlatformNetwork object but got %T", oldObj)
+	}
+	return validateDeploymentScopeUpdate(old, platformNetwork, !equality.Semantic.DeepEqual(old.Spec, platformNetwork.Spec))
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022,2025 Wind River Systems, Inc. */
+/* Copyright(c) 2022,2025-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -102,7 +103,11 @@ func (v *PtpInstanceCustomValidator) ValidateCreate(ctx context.Context, obj run
 		return nil, fmt.Errorf("expected a PtpInstance object but got %T", obj)
 	}
 	ptpinstancelog.Info("validate create", "name", ptpInstance.Name)
-	return nil, validatePtpInstance(ptpInstance)
+	warnings, err := validateDeploymentScope(ptpInstance)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatePtpInstance(ptpInstance)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -112,7 +117,15 @@ func (d *PtpInstanceCustomValidator) ValidateUpdate(cxt context.Context, oldObj,
 		return nil, fmt.Errorf("expected a PtpInstance object but got %T", newObj)
 	}
 	ptpinstancelog.Info("validate update", "name", ptpInstance.Name)
-	return nil, validatePtpInstance(ptpInstance)
+	old, ok := oldObj.(*starlingxv1.PtpInstance)
+	if !ok {
+		return nil, fmt.Errorf("expected a PtpInstance object but got %T", oldObj)
+	}
+	warnings, err := validateDeploymentScopeUpdate(old, ptpInstance, !equality.Semantic.DeepEqual(old.Spec, ptpInstance.Spec))
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatePtpInstance(ptpInstance)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
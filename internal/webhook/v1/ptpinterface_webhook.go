@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2022, 2024-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2022, 2024-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -91,7 +92,11 @@ func (v *PtpInterfaceCustomValidator) ValidateCreate(ctx context.Context, obj ru
 		return nil, fmt.Errorf("expected a PtpInterface object but got %T", obj)
 	}
 	ptpinterfacelog.Info("validate create", "name", ptpInterface.Name)
-	return nil, validatePtpInterface(ptpInterface)
+	warnings, err := validateDeploymentScope(ptpInterface)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatePtpInterface(ptpInterface)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -101,7 +106,15 @@ func (d *PtpInterfaceCustomValidator) ValidateUpdate(cxt context.Context, oldObj
 		return nil, fmt.Errorf("expected a PtpInterface object but got %T", newObj)
 	}
 	ptpinterfacelog.Info("validate update", "name", ptpInterface.Name)
-	return nil, validatePtpInterface(ptpInterface)
+	old, ok := oldObj.(*starlingxv1.PtpInterface)
+	if !ok {
+		return nil, fmt.Errorf("expected a PtpInterface object but got %T", oldObj)
+	}
+	warnings, err := validateDeploymentScopeUpdate(old, ptpInterface, !equality.Semantic.DeepEqual(old.Spec, ptpInterface.Spec))
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatePtpInterface(ptpInterface)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
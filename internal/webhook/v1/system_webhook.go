@@ -1,5 +1,5 @@
 /* SPDX-License-Identifier: Apache-2.0 */
-/* Copyright(c) 2019-2025 Wind River Systems, Inc. */
+/* Copyright(c) 2019-2026 Wind River Systems, Inc. */
 
 package v1
 
@@ -11,6 +11,7 @@ import (
 
 	starlingxv1 "github.com/wind-river/cloud-platform-deployment-manager/api/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -211,6 +212,83 @@ func validateCertificates(obj *starlingxv1.System) error {
 	return nil
 }
 
+func validateStrategy(obj *starlingxv1.System) error {
+	strategy := obj.Spec.Strategy
+	if strategy == nil {
+		return nil
+	}
+
+	if strategy.AlarmRestrictions != nil {
+		switch *strategy.AlarmRestrictions {
+		case starlingxv1.StrategyAlarmRestrictionsStrict, starlingxv1.StrategyAlarmRestrictionsRelaxed:
+		default:
+			return fmt.Errorf("strategy alarm restrictions must be one of %q or %q",
+				starlingxv1.StrategyAlarmRestrictionsStrict, starlingxv1.StrategyAlarmRestrictionsRelaxed)
+		}
+	}
+
+	for _, applyType := range []*string{strategy.WorkerApplyType, strategy.StorageApplyType} {
+		if applyType == nil {
+			continue
+		}
+
+		switch *applyType {
+		case starlingxv1.StrategyApplyTypeSerial, starlingxv1.StrategyApplyTypeParallel:
+		default:
+			return fmt.Errorf("strategy apply types must be one of %q or %q",
+				starlingxv1.StrategyApplyTypeSerial, starlingxv1.StrategyApplyTypeParallel)
+		}
+	}
+
+	if strategy.DefaultInstanceAction != nil {
+		switch *strategy.DefaultInstanceAction {
+		case starlingxv1.StrategyInstanceActionStopStart, starlingxv1.StrategyInstanceActionMigrate:
+		default:
+			return fmt.Errorf("strategy default instance action must be one of %q or %q",
+				starlingxv1.StrategyInstanceActionStopStart, starlingxv1.StrategyInstanceActionMigrate)
+		}
+	}
+
+	if strategy.ApprovalMode != nil {
+		switch *strategy.ApprovalMode {
+		case starlingxv1.StrategyApprovalAuto, starlingxv1.StrategyApprovalManual, starlingxv1.StrategyApprovalStage:
+		default:
+			return fmt.Errorf("strategy approval mode must be one of %q, %q or %q",
+				starlingxv1.StrategyApprovalAuto, starlingxv1.StrategyApprovalManual, starlingxv1.StrategyApprovalStage)
+		}
+	}
+
+	if strategy.MaxParallelWorkers != nil {
+		value := *strategy.MaxParallelWorkers
+		if value < starlingxv1.StrategyMinParallelWorkers || value > starlingxv1.StrategyMaxParallelWorkers {
+			return fmt.Errorf("strategy max parallel workers must be between %d and %d",
+				starlingxv1.StrategyMinParallelWorkers, starlingxv1.StrategyMaxParallelWorkers)
+		}
+
+		if strategy.WorkerApplyType != nil && *strategy.WorkerApplyType == starlingxv1.StrategyApplyTypeSerial {
+			return errors.New("strategy max parallel workers is only applicable to a parallel worker apply type")
+		}
+	}
+
+	if strategy.MaxRetries != nil {
+		value := *strategy.MaxRetries
+		if value < starlingxv1.StrategyMinRetries || value > starlingxv1.StrategyMaxRetries {
+			return fmt.Errorf("strategy max retries must be between %d and %d",
+				starlingxv1.StrategyMinRetries, starlingxv1.StrategyMaxRetries)
+		}
+	}
+
+	if strategy.MaxRetryInterval != nil {
+		value := *strategy.MaxRetryInterval
+		if value < starlingxv1.StrategyMinRetryInterval || value > starlingxv1.StrategyMaxRetryInterval {
+			return fmt.Errorf("strategy max retry interval must be between %d and %d seconds",
+				starlingxv1.StrategyMinRetryInterval, starlingxv1.StrategyMaxRetryInterval)
+		}
+	}
+
+	return nil
+}
+
 func validatingSystem(r *starlingxv1.System) error {
 	err := validateStorage(r)
 	if err != nil {
@@ -222,6 +300,18 @@ func validatingSystem(r *starlingxv1.System) error {
 		return err
 	}
 
+	err = validateStrategy(r)
+	if err != nil {
+		return err
+	}
+
+	if r.Spec.Maintenance != nil {
+		err = r.Spec.Maintenance.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	systemlog.Info(SystemAllowedReason)
 	return nil
 }
@@ -240,7 +330,11 @@ func (v *SystemCustomValidator) ValidateCreate(ctx context.Context, obj runtime.
 		return nil, fmt.Errorf("expected a System object but got %T", obj)
 	}
 	systemlog.Info("validate create", "name", system.Name)
-	return nil, validatingSystem(system)
+	warnings, err := validateDeploymentScope(system)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatingSystem(system)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -250,7 +344,15 @@ func (v *SystemCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newO
 		return nil, fmt.Errorf("expected a System object but got %T", newObj)
 	}
 	systemlog.Info("validate update", "name", system.Name)
-	return nil, validatingSystem(system)
+	old, ok := oldObj.(*starlingxv1.System)
+	if !ok {
+		return nil, fmt.Errorf("expected a System object but got %T", oldObj)
+	}
+	warnings, err := validateDeploymentScopeUpdate(old, system, !equality.Semantic.DeepEqual(old.Spec, system.Spec))
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, validatingSystem(system)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
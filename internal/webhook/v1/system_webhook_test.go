@@ -260,6 +260,71 @@ var _ = Describe("SystemWebhook", func() {
 			})
 		})
 	})
+	Describe("ValidateStrategy", func() {
+		Context("when the strategy options are valid", func() {
+			It("should return nil error", func() {
+				alarms := starlingxv1.StrategyAlarmRestrictionsRelaxed
+				worker := starlingxv1.StrategyApplyTypeParallel
+				workers := 20
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						Strategy: &starlingxv1.StrategyInfo{
+							AlarmRestrictions:  &alarms,
+							WorkerApplyType:    &worker,
+							MaxParallelWorkers: &workers,
+						},
+					},
+				}
+
+				err := validateStrategy(obj)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+		Context("when the strategy options are out of range", func() {
+			It("should return an error", func() {
+				action := "reboot"
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						Strategy: &starlingxv1.StrategyInfo{DefaultInstanceAction: &action},
+					},
+				}
+				Expect(validateStrategy(obj)).To(HaveOccurred())
+
+				workers := 1
+				obj.Spec.Strategy = &starlingxv1.StrategyInfo{MaxParallelWorkers: &workers}
+				Expect(validateStrategy(obj)).To(HaveOccurred())
+
+				mode := "later"
+				obj.Spec.Strategy = &starlingxv1.StrategyInfo{ApprovalMode: &mode}
+				Expect(validateStrategy(obj)).To(HaveOccurred())
+
+				retries := 0
+				obj.Spec.Strategy = &starlingxv1.StrategyInfo{MaxRetries: &retries}
+				Expect(validateStrategy(obj)).To(HaveOccurred())
+
+				interval := 5
+				obj.Spec.Strategy = &starlingxv1.StrategyInfo{MaxRetryInterval: &interval}
+				Expect(validateStrategy(obj)).To(HaveOccurred())
+			})
+		})
+		Context("when max parallel workers is set with a serial worker apply type", func() {
+			It("should return an error", func() {
+				worker := starlingxv1.StrategyApplyTypeSerial
+				workers := 20
+				obj := &starlingxv1.System{
+					Spec: starlingxv1.SystemSpec{
+						Strategy: &starlingxv1.StrategyInfo{
+							WorkerApplyType:    &worker,
+							MaxParallelWorkers: &workers,
+						},
+					},
+				}
+
+				err := validateStrategy(obj)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 var _ = Describe("SystemWebhook integration", func() {